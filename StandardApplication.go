@@ -3,9 +3,11 @@ package jellui
 import (
 	"fmt"
 	"os"
+	"time"
 
 	mgl "github.com/go-gl/mathgl/mgl32"
 
+	"github.com/dertseha/jellui/animation"
 	"github.com/dertseha/jellui/area"
 	"github.com/dertseha/jellui/area/events"
 	"github.com/dertseha/jellui/controls"
@@ -26,6 +28,7 @@ type StandardApplication struct {
 
 	mouseX, mouseY float32
 	mouseButtons   uint32
+	keyModifier    input.Modifier
 
 	uiFontPainter        graphics.TextPainter
 	uiTextScale          float32
@@ -33,8 +36,15 @@ type StandardApplication struct {
 	uiTextPaletteTexture *graphics.PaletteTexture
 	rectRenderer         *graphics.RectangleRenderer
 	uiTextRenderer       *graphics.BitmapTextureRenderer
+	clipper              *graphics.ScissorClipper
 
-	rootArea *area.Area
+	rootArea   *area.Area
+	dispatcher *area.Dispatcher
+	overlays   *area.OverlayManager
+	tooltips   *controls.Tooltips
+
+	animator      *animation.Animator
+	lastFrameTime time.Time
 
 	uiSetup func(*StandardApplication, *area.Area)
 }
@@ -45,6 +55,7 @@ func NewStandardApplication(uiSetup func(*StandardApplication, *area.Area)) *Sta
 	return &StandardApplication{
 		uiSetup:     uiSetup,
 		uiTextScale: 1.0,
+		animator:    animation.NewAnimator(),
 		uiTextPalette: map[int][4]byte{
 			0: {0x00, 0x00, 0x00, 0x00},
 			1: {0x80, 0x94, 0x54, 0xFF},
@@ -66,6 +77,26 @@ func (app *StandardApplication) SetUITextScale(scale float32) {
 	app.uiTextScale = scale
 }
 
+// Dispatcher returns the dispatcher which forwards the window events into the UI tree.
+func (app *StandardApplication) Dispatcher() *area.Dispatcher {
+	return app.dispatcher
+}
+
+// OverlayManager returns the manager of the modal layers on top of the root area.
+func (app *StandardApplication) OverlayManager() *area.OverlayManager {
+	return app.overlays
+}
+
+// Tooltips returns the handler which shows the tooltips of hovered areas.
+func (app *StandardApplication) Tooltips() *controls.Tooltips {
+	return app.tooltips
+}
+
+// Animator returns the animator which is advanced with every rendered frame.
+func (app *StandardApplication) Animator() *animation.Animator {
+	return app.animator
+}
+
 // SetUITextPalette sets the palette for UI texts.
 func (app *StandardApplication) SetUITextPalette(palette map[int][4]byte) {
 	for key, color := range palette {
@@ -97,6 +128,10 @@ func (app *StandardApplication) setWindow(glWindow env.OpenGlWindow) {
 	glWindow.OnMouseButtonDown(app.onMouseButtonDown)
 	glWindow.OnMouseButtonUp(app.onMouseButtonUp)
 	glWindow.OnMouseScroll(app.onMouseScroll)
+
+	glWindow.OnKey(app.onKey)
+	glWindow.OnModifier(app.onModifier)
+	glWindow.OnCharCallback(app.onChar)
 }
 
 func (app *StandardApplication) initOpenGl() {
@@ -132,6 +167,7 @@ func (app *StandardApplication) initGraphics() {
 	app.uiFontPainter = graphics.NewBitmapTextPainter(font.SmallShock, 0x02)
 
 	app.rectRenderer = graphics.NewRectangleRenderer(app.gl, &app.projectionMatrix)
+	app.clipper = graphics.NewScissorClipper(app.gl)
 }
 
 func (app *StandardApplication) initInterface() {
@@ -139,14 +175,23 @@ func (app *StandardApplication) initInterface() {
 
 	rootBuilder.SetRight(area.NewAbsoluteAnchor(0.0))
 	rootBuilder.SetBottom(area.NewAbsoluteAnchor(0.0))
+	rootBuilder.SetClipper(app.clipper)
 
 	app.rootArea = rootBuilder.Build()
+	app.dispatcher = area.NewDispatcher(app.rootArea)
+	app.overlays = area.NewOverlayManager(app.rootArea)
+	app.overlays.SetBackgroundRenderer(func(area *area.Area) {
+		app.rectRenderer.Fill(area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value(),
+			graphics.RGBA(0.0, 0.0, 0.0, 0.5))
+	})
+	app.tooltips = controls.NewTooltips(app.rootArea, app.dispatcher, app.ForLabel(), app.rectRenderer)
 	app.uiSetup(app, app.rootArea)
 }
 
 func (app *StandardApplication) onWindowResize(width int, height int) {
 	app.projectionMatrix = mgl.Ortho2D(0.0, float32(width), float32(height), 0.0)
 	app.gl.Viewport(0, 0, int32(width), int32(height))
+	app.clipper.SetViewportHeight(height)
 
 	app.rootArea.Right().RequestValue(float32(width))
 	app.rootArea.Bottom().RequestValue(float32(height))
@@ -154,31 +199,73 @@ func (app *StandardApplication) onWindowResize(width int, height int) {
 
 func (app *StandardApplication) render() {
 	gl := app.gl
+	now := time.Now()
+
+	if !app.lastFrameTime.IsZero() {
+		elapsed := now.Sub(app.lastFrameTime)
+		app.animator.Tick(elapsed)
+		app.tooltips.Update(elapsed)
+	}
+	app.lastFrameTime = now
 
 	gl.Clear(opengl.COLOR_BUFFER_BIT)
 	app.rootArea.Render()
+	app.renderFocusIndicator()
+}
+
+func (app *StandardApplication) renderFocusIndicator() {
+	focused := app.dispatcher.FocusManager().Focused()
+
+	if focused != nil {
+		left, top := focused.Left().Value(), focused.Top().Value()
+		right, bottom := focused.Right().Value(), focused.Bottom().Value()
+		color := graphics.RGBA(1.0, 0.0, 0.34, 0.8)
+
+		app.rectRenderer.Fill(left-1, top-1, right+1, top, color)
+		app.rectRenderer.Fill(left-1, bottom, right+1, bottom+1, color)
+		app.rectRenderer.Fill(left-1, top, left, bottom, color)
+		app.rectRenderer.Fill(right, top, right+1, bottom, color)
+	}
 }
 
 func (app *StandardApplication) onMouseMove(x float32, y float32) {
 	app.mouseX, app.mouseY = x, y
-	app.rootArea.DispatchPositionalEvent(events.NewMouseMoveEvent(x, y, 0, 0))
+	app.dispatchPositionalEvent(events.NewMouseMoveEvent(x, y, uint32(app.keyModifier), app.mouseButtons))
 }
 
 func (app *StandardApplication) onMouseButtonDown(mouseButton uint32, modifier input.Modifier) {
 	app.mouseButtons |= mouseButton
-	app.rootArea.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
-		app.mouseX, app.mouseY, 0, app.mouseButtons, mouseButton))
+	app.dispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
+		app.mouseX, app.mouseY, uint32(modifier), app.mouseButtons, mouseButton))
 }
 
 func (app *StandardApplication) onMouseButtonUp(mouseButton uint32, modifier input.Modifier) {
 	app.mouseButtons &= ^mouseButton
-	app.rootArea.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonUpEventType,
-		app.mouseX, app.mouseY, 0, app.mouseButtons, mouseButton))
+	app.dispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonUpEventType,
+		app.mouseX, app.mouseY, uint32(modifier), app.mouseButtons, mouseButton))
 }
 
 func (app *StandardApplication) onMouseScroll(dx float32, dy float32) {
-	app.rootArea.DispatchPositionalEvent(events.NewMouseScrollEvent(
-		app.mouseX, app.mouseY, 0, app.mouseButtons, dx, dy))
+	app.dispatchPositionalEvent(events.NewMouseScrollEvent(
+		app.mouseX, app.mouseY, uint32(app.keyModifier), app.mouseButtons, dx, dy))
+}
+
+func (app *StandardApplication) dispatchPositionalEvent(event events.PositionalEvent) {
+	app.dispatcher.DispatchPositionalEvent(event)
+	app.tooltips.HandlePositionalEvent(event)
+}
+
+func (app *StandardApplication) onKey(key input.Key, modifier input.Modifier) {
+	app.dispatcher.HandleEvent(events.NewKeyEvent(key, modifier))
+}
+
+func (app *StandardApplication) onModifier(modifier input.Modifier) {
+	app.keyModifier = modifier
+	app.dispatcher.HandleEvent(events.NewModifierEvent(modifier))
+}
+
+func (app *StandardApplication) onChar(char rune) {
+	app.dispatcher.HandleEvent(events.NewCharEvent(char, app.keyModifier))
 }
 
 // RectangleRenderer implements the graphics.Context interface.
@@ -215,15 +302,75 @@ func (app *StandardApplication) ForLabel() *controls.LabelBuilder {
 
 // ForTextButton implements the controls.Factory interface.
 func (app *StandardApplication) ForTextButton() *controls.TextButtonBuilder {
-	return controls.NewTextButtonBuilder(app.ForLabel(), app.rectRenderer)
+	return controls.NewTextButtonBuilder(app.ForLabel(), app.rectRenderer).WithAnimator(app.animator)
 }
 
 // ForComboBox implements the controls.Factory interface.
 func (app *StandardApplication) ForComboBox() *controls.ComboBoxBuilder {
-	return controls.NewComboBoxBuilder(app.ForLabel(), app.rectRenderer)
+	return controls.NewComboBoxBuilder(app.ForLabel(), app.rectRenderer).WithOverlays(app.overlays)
 }
 
 // ForSlider implements the controls.Factory interface.
 func (app *StandardApplication) ForSlider() *controls.SliderBuilder {
 	return controls.NewSliderBuilder(app.ForLabel(), app.rectRenderer)
 }
+
+// ForTextField implements the controls.Factory interface.
+func (app *StandardApplication) ForTextField() *controls.TextFieldBuilder {
+	return controls.NewTextFieldBuilder(app.ForLabel(), app.rectRenderer, app.glWindow)
+}
+
+// ForScrollPane implements the controls.Factory interface.
+func (app *StandardApplication) ForScrollPane() *controls.ScrollPaneBuilder {
+	return controls.NewScrollPaneBuilder(app.rectRenderer)
+}
+
+// ForDialog implements the controls.Factory interface.
+func (app *StandardApplication) ForDialog() *controls.DialogBuilder {
+	return controls.NewDialogBuilder(app.ForLabel(), app.ForTextButton, app.rectRenderer, app.overlays)
+}
+
+// ForMenu implements the controls.Factory interface.
+func (app *StandardApplication) ForMenu() *controls.MenuBuilder {
+	return controls.NewMenuBuilder(app.ForLabel(), app.rectRenderer, app.overlays)
+}
+
+// ForMenuBar implements the controls.Factory interface.
+func (app *StandardApplication) ForMenuBar() *controls.MenuBarBuilder {
+	return controls.NewMenuBarBuilder(app.ForLabel(), app.rectRenderer, app.overlays)
+}
+
+// ForCheckBox implements the controls.Factory interface.
+func (app *StandardApplication) ForCheckBox() *controls.CheckBoxBuilder {
+	return controls.NewCheckBoxBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForRadioGroup implements the controls.Factory interface.
+func (app *StandardApplication) ForRadioGroup() *controls.RadioGroupBuilder {
+	return controls.NewRadioGroupBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTextArea implements the controls.Factory interface.
+func (app *StandardApplication) ForTextArea() *controls.TextAreaBuilder {
+	return controls.NewTextAreaBuilder(app.ForLabel(), app.rectRenderer, app.glWindow)
+}
+
+// ForListView implements the controls.Factory interface.
+func (app *StandardApplication) ForListView() *controls.ListViewBuilder {
+	return controls.NewListViewBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTable implements the controls.Factory interface.
+func (app *StandardApplication) ForTable() *controls.TableBuilder {
+	return controls.NewTableBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTreeView implements the controls.Factory interface.
+func (app *StandardApplication) ForTreeView() *controls.TreeViewBuilder {
+	return controls.NewTreeViewBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTabPanel implements the controls.Factory interface.
+func (app *StandardApplication) ForTabPanel() *controls.TabPanelBuilder {
+	return controls.NewTabPanelBuilder(app.ForLabel(), app.rectRenderer)
+}
@@ -52,6 +52,7 @@ type controlsTestApplication struct {
 	uiTextRenderer   *graphics.BitmapTextureRenderer
 
 	rootArea *area.Area
+	overlays *area.OverlayManager
 }
 
 func newControlsTestApplication() *controlsTestApplication {
@@ -162,6 +163,11 @@ func (app *controlsTestApplication) initInterface() {
 	})
 
 	app.rootArea = rootBuilder.Build()
+	app.overlays = area.NewOverlayManager(app.rootArea)
+	app.overlays.SetBackgroundRenderer(func(area *area.Area) {
+		app.rectRenderer.Fill(area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value(),
+			graphics.RGBA(0.0, 0.0, 0.0, 0.5))
+	})
 
 	lastBottom := app.rootArea.Top()
 	{
@@ -294,10 +300,70 @@ func (app *controlsTestApplication) ForTextButton() *controls.TextButtonBuilder
 
 // ForComboBox implements the controls.Factory interface.
 func (app *controlsTestApplication) ForComboBox() *controls.ComboBoxBuilder {
-	return controls.NewComboBoxBuilder(app.ForLabel(), app.rectRenderer)
+	return controls.NewComboBoxBuilder(app.ForLabel(), app.rectRenderer).WithOverlays(app.overlays)
 }
 
 // ForSlider implements the controls.Factory interface.
 func (app *controlsTestApplication) ForSlider() *controls.SliderBuilder {
 	return controls.NewSliderBuilder(app.ForLabel(), app.rectRenderer)
 }
+
+// ForTextField implements the controls.Factory interface.
+func (app *controlsTestApplication) ForTextField() *controls.TextFieldBuilder {
+	return controls.NewTextFieldBuilder(app.ForLabel(), app.rectRenderer, app.glWindow)
+}
+
+// ForScrollPane implements the controls.Factory interface.
+func (app *controlsTestApplication) ForScrollPane() *controls.ScrollPaneBuilder {
+	return controls.NewScrollPaneBuilder(app.rectRenderer)
+}
+
+// ForDialog implements the controls.Factory interface.
+func (app *controlsTestApplication) ForDialog() *controls.DialogBuilder {
+	return controls.NewDialogBuilder(app.ForLabel(), app.ForTextButton, app.rectRenderer, app.overlays)
+}
+
+// ForMenu implements the controls.Factory interface.
+func (app *controlsTestApplication) ForMenu() *controls.MenuBuilder {
+	return controls.NewMenuBuilder(app.ForLabel(), app.rectRenderer, app.overlays)
+}
+
+// ForMenuBar implements the controls.Factory interface.
+func (app *controlsTestApplication) ForMenuBar() *controls.MenuBarBuilder {
+	return controls.NewMenuBarBuilder(app.ForLabel(), app.rectRenderer, app.overlays)
+}
+
+// ForCheckBox implements the controls.Factory interface.
+func (app *controlsTestApplication) ForCheckBox() *controls.CheckBoxBuilder {
+	return controls.NewCheckBoxBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForRadioGroup implements the controls.Factory interface.
+func (app *controlsTestApplication) ForRadioGroup() *controls.RadioGroupBuilder {
+	return controls.NewRadioGroupBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTextArea implements the controls.Factory interface.
+func (app *controlsTestApplication) ForTextArea() *controls.TextAreaBuilder {
+	return controls.NewTextAreaBuilder(app.ForLabel(), app.rectRenderer, app.glWindow)
+}
+
+// ForListView implements the controls.Factory interface.
+func (app *controlsTestApplication) ForListView() *controls.ListViewBuilder {
+	return controls.NewListViewBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTable implements the controls.Factory interface.
+func (app *controlsTestApplication) ForTable() *controls.TableBuilder {
+	return controls.NewTableBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTreeView implements the controls.Factory interface.
+func (app *controlsTestApplication) ForTreeView() *controls.TreeViewBuilder {
+	return controls.NewTreeViewBuilder(app.ForLabel(), app.rectRenderer)
+}
+
+// ForTabPanel implements the controls.Factory interface.
+func (app *controlsTestApplication) ForTabPanel() *controls.TabPanelBuilder {
+	return controls.NewTabPanelBuilder(app.ForLabel(), app.rectRenderer)
+}
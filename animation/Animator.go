@@ -0,0 +1,50 @@
+package animation
+
+import (
+	"time"
+)
+
+// Animation is something that progresses over time.
+type Animation interface {
+	// Advance progresses the animation by given time. It returns true when the animation is finished.
+	Advance(elapsed time.Duration) (finished bool)
+}
+
+// Animator drives a set of animations. Finished animations are removed.
+type Animator struct {
+	animations []Animation
+}
+
+// NewAnimator returns a new animator without any animations.
+func NewAnimator() *Animator {
+	return &Animator{}
+}
+
+// Add starts the given animation with the next tick.
+func (animator *Animator) Add(animation Animation) {
+	animator.animations = append(animator.animations, animation)
+}
+
+// Tween creates a tween and adds it to the animator.
+func (animator *Animator) Tween(from, to []float32, duration time.Duration, easing Easing, update TweenUpdate) *Tween {
+	tween := NewTween(from, to, duration, easing, update)
+	animator.Add(tween)
+	return tween
+}
+
+// IsIdle returns true if there are no running animations.
+func (animator *Animator) IsIdle() bool {
+	return len(animator.animations) == 0
+}
+
+// Tick advances all animations by given time.
+func (animator *Animator) Tick(elapsed time.Duration) {
+	current := animator.animations
+	animator.animations = nil
+
+	for _, animation := range current {
+		if !animation.Advance(elapsed) {
+			animator.animations = append(animator.animations, animation)
+		}
+	}
+}
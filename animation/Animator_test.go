@@ -0,0 +1,47 @@
+package animation
+
+import (
+	"time"
+
+	check "gopkg.in/check.v1"
+)
+
+type AnimatorSuite struct {
+}
+
+var _ = check.Suite(&AnimatorSuite{})
+
+func (suite *AnimatorSuite) TestTickAdvancesAnimations(c *check.C) {
+	animator := NewAnimator()
+	var value float32
+	animator.Tween([]float32{0.0}, []float32{10.0}, 100*time.Millisecond, Linear, func(values []float32) {
+		value = values[0]
+	})
+
+	animator.Tick(20 * time.Millisecond)
+
+	c.Check(value, check.Equals, float32(2.0))
+}
+
+func (suite *AnimatorSuite) TestFinishedAnimationsAreRemoved(c *check.C) {
+	animator := NewAnimator()
+	animator.Tween([]float32{0.0}, []float32{10.0}, 100*time.Millisecond, Linear, func([]float32) {})
+
+	animator.Tick(50 * time.Millisecond)
+	c.Check(animator.IsIdle(), check.Equals, false)
+	animator.Tick(50 * time.Millisecond)
+	c.Check(animator.IsIdle(), check.Equals, true)
+}
+
+func (suite *AnimatorSuite) TestAnimationsAddedDuringTickStartWithNextTick(c *check.C) {
+	animator := NewAnimator()
+	calls := 0
+	animator.Tween([]float32{0.0}, []float32{1.0}, 0, Linear, func([]float32) {
+		animator.Tween([]float32{0.0}, []float32{1.0}, 0, Linear, func([]float32) { calls++ })
+	})
+
+	animator.Tick(0)
+	c.Check(calls, check.Equals, 0)
+	animator.Tick(0)
+	c.Check(calls, check.Equals, 1)
+}
@@ -0,0 +1,33 @@
+package animation
+
+// Easing maps the progress of an animation, in range [0..1], to the progress of the animated value.
+type Easing func(t float32) float32
+
+// Linear progresses the value uniformly.
+func Linear(t float32) float32 {
+	return t
+}
+
+// EaseInQuad starts slowly and accelerates.
+func EaseInQuad(t float32) float32 {
+	return t * t
+}
+
+// EaseOutQuad starts quickly and decelerates.
+func EaseOutQuad(t float32) float32 {
+	return t * (2 - t)
+}
+
+// EaseInOutQuad accelerates until halfway, then decelerates.
+func EaseInOutQuad(t float32) float32 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// EaseOutCubic starts quickly and decelerates stronger than EaseOutQuad.
+func EaseOutCubic(t float32) float32 {
+	inverse := 1 - t
+	return 1 - inverse*inverse*inverse
+}
@@ -0,0 +1,72 @@
+package animation
+
+import (
+	"time"
+)
+
+// TweenUpdate is called with the current values of a tween.
+type TweenUpdate func(values []float32)
+
+// Tween interpolates a set of values, such as the components of a color, over time.
+type Tween struct {
+	from     []float32
+	to       []float32
+	duration time.Duration
+	easing   Easing
+	update   TweenUpdate
+
+	elapsed time.Duration
+	stopped bool
+	values  []float32
+}
+
+// NewTween returns a tween from one set of values to another. Both sets must have the same length.
+// A nil easing is regarded as Linear.
+func NewTween(from, to []float32, duration time.Duration, easing Easing, update TweenUpdate) *Tween {
+	if easing == nil {
+		easing = Linear
+	}
+	tween := &Tween{
+		from:     append([]float32{}, from...),
+		to:       append([]float32{}, to...),
+		duration: duration,
+		easing:   easing,
+		update:   update,
+		values:   append([]float32{}, from...)}
+
+	return tween
+}
+
+// Values returns the current values.
+func (tween *Tween) Values() []float32 {
+	return tween.values
+}
+
+// Stop ends the tween at its current values. The update function will not be called anymore.
+func (tween *Tween) Stop() {
+	tween.stopped = true
+}
+
+// IsFinished returns true if the tween reached its end, or was stopped.
+func (tween *Tween) IsFinished() bool {
+	return tween.stopped || (tween.elapsed >= tween.duration)
+}
+
+// Advance implements the Animation interface.
+func (tween *Tween) Advance(elapsed time.Duration) bool {
+	if !tween.stopped {
+		progress := float32(1.0)
+
+		tween.elapsed += elapsed
+		if tween.elapsed < tween.duration {
+			progress = float32(tween.elapsed) / float32(tween.duration)
+		}
+		eased := tween.easing(progress)
+		for index := range tween.values {
+			tween.values[index] = tween.from[index] + (tween.to[index]-tween.from[index])*eased
+		}
+		tween.update(tween.values)
+	}
+
+	return tween.IsFinished()
+}
@@ -0,0 +1,67 @@
+package animation
+
+import (
+	"time"
+
+	check "gopkg.in/check.v1"
+)
+
+type TweenSuite struct {
+	updates [][]float32
+}
+
+var _ = check.Suite(&TweenSuite{})
+
+func (suite *TweenSuite) SetUpTest(c *check.C) {
+	suite.updates = nil
+}
+
+func (suite *TweenSuite) record(values []float32) {
+	suite.updates = append(suite.updates, append([]float32{}, values...))
+}
+
+func (suite *TweenSuite) TestAdvanceInterpolatesValues(c *check.C) {
+	tween := NewTween([]float32{0.0, 10.0}, []float32{10.0, 30.0}, 100*time.Millisecond, Linear, suite.record)
+
+	finished := tween.Advance(50 * time.Millisecond)
+
+	c.Check(finished, check.Equals, false)
+	c.Check(suite.updates, check.DeepEquals, [][]float32{{5.0, 20.0}})
+}
+
+func (suite *TweenSuite) TestAdvanceEndsAtTargetValues(c *check.C) {
+	tween := NewTween([]float32{0.0}, []float32{10.0}, 100*time.Millisecond, EaseOutQuad, suite.record)
+
+	tween.Advance(60 * time.Millisecond)
+	finished := tween.Advance(60 * time.Millisecond)
+
+	c.Check(finished, check.Equals, true)
+	c.Check(tween.Values(), check.DeepEquals, []float32{10.0})
+}
+
+func (suite *TweenSuite) TestAdvanceUsesEasing(c *check.C) {
+	tween := NewTween([]float32{0.0}, []float32{100.0}, 100*time.Millisecond, EaseInQuad, suite.record)
+
+	tween.Advance(50 * time.Millisecond)
+
+	c.Check(tween.Values(), check.DeepEquals, []float32{25.0})
+}
+
+func (suite *TweenSuite) TestStoppedTweenDoesNotUpdate(c *check.C) {
+	tween := NewTween([]float32{0.0}, []float32{10.0}, 100*time.Millisecond, Linear, suite.record)
+
+	tween.Stop()
+	finished := tween.Advance(50 * time.Millisecond)
+
+	c.Check(finished, check.Equals, true)
+	c.Check(suite.updates, check.IsNil)
+}
+
+func (suite *TweenSuite) TestZeroDurationJumpsToTarget(c *check.C) {
+	tween := NewTween([]float32{0.0}, []float32{10.0}, 0, nil, suite.record)
+
+	finished := tween.Advance(0)
+
+	c.Check(finished, check.Equals, true)
+	c.Check(suite.updates, check.DeepEquals, [][]float32{{10.0}})
+}
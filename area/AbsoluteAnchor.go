@@ -1,6 +1,7 @@
 package area
 
 type absoluteAnchor struct {
+	changeNotifier
 	value float32
 }
 
@@ -15,5 +16,8 @@ func (anchor *absoluteAnchor) Value() float32 {
 }
 
 func (anchor *absoluteAnchor) RequestValue(newValue float32) {
-	anchor.value = newValue
+	if newValue != anchor.value {
+		anchor.value = newValue
+		anchor.notify()
+	}
 }
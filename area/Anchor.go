@@ -11,3 +11,20 @@ type Anchor interface {
 	// ignored alltogether.
 	RequestValue(newValue float32)
 }
+
+// DependentAnchor is an anchor that derives its value from other anchors.
+type DependentAnchor interface {
+	Anchor
+
+	// Dependencies returns the anchors the value is derived from.
+	Dependencies() []Anchor
+}
+
+// AnchorDependencies returns the anchors given anchor derives its value from.
+// Anchors that do not implement DependentAnchor have no dependencies.
+func AnchorDependencies(anchor Anchor) (result []Anchor) {
+	if dependent, isDependent := anchor.(DependentAnchor); isDependent {
+		result = dependent.Dependencies()
+	}
+	return
+}
@@ -0,0 +1,141 @@
+package area
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Edge identifies one of the four edges of an area.
+type Edge int
+
+const (
+	// LeftEdge is the left edge of an area.
+	LeftEdge = Edge(0)
+	// TopEdge is the top edge of an area.
+	TopEdge = Edge(1)
+	// RightEdge is the right edge of an area.
+	RightEdge = Edge(2)
+	// BottomEdge is the bottom edge of an area.
+	BottomEdge = Edge(3)
+)
+
+var edgeNames = map[Edge]string{LeftEdge: "left", TopEdge: "top", RightEdge: "right", BottomEdge: "bottom"}
+
+func (edge Edge) String() string {
+	return edgeNames[edge]
+}
+
+// AreaEdge identifies an edge of a specific area.
+type AreaEdge struct {
+	// Area is the area the edge belongs to.
+	Area *Area
+	// Edge is the edge of the area.
+	Edge Edge
+	// Path describes the position of the area in the tree, as list of child indices starting at the root "/".
+	Path string
+}
+
+func (areaEdge AreaEdge) String() string {
+	return areaEdge.Path + "." + areaEdge.Edge.String()
+}
+
+// AnchorCycle describes a set of anchors that depend on each other.
+type AnchorCycle struct {
+	// Anchors are the anchors in the cycle, in order of their dependency.
+	Anchors []Anchor
+	// Edges are the area edges directly using an anchor of the cycle.
+	Edges []AreaEdge
+}
+
+// AnchorCycleError is returned by ValidateAnchors if cycles were found.
+type AnchorCycleError struct {
+	Cycles []AnchorCycle
+}
+
+func (err *AnchorCycleError) Error() string {
+	descriptions := make([]string, len(err.Cycles))
+	for index, cycle := range err.Cycles {
+		edges := make([]string, len(cycle.Edges))
+		for edgeIndex, edge := range cycle.Edges {
+			edges[edgeIndex] = edge.String()
+		}
+		descriptions[index] = fmt.Sprintf("%d anchors, edges [%s]", len(cycle.Anchors), strings.Join(edges, ", "))
+	}
+	return "anchor cycles: " + strings.Join(descriptions, "; ")
+}
+
+type anchorVisitState int
+
+const (
+	anchorUnvisited = anchorVisitState(0)
+	anchorVisiting  = anchorVisitState(1)
+	anchorVisited   = anchorVisitState(2)
+)
+
+type anchorValidator struct {
+	edges  map[Anchor][]AreaEdge
+	states map[Anchor]anchorVisitState
+	stack  []Anchor
+	cycles []AnchorCycle
+}
+
+// ValidateAnchors walks the tree of given root area and checks the anchors of all areas for
+// dependency cycles, which would otherwise cause an endless recursion when retrieving a value.
+// Only dependencies of anchors implementing DependentAnchor can be checked.
+// Returns an *AnchorCycleError if cycles were found, nil otherwise.
+func ValidateAnchors(root *Area) error {
+	validator := &anchorValidator{
+		edges:  make(map[Anchor][]AreaEdge),
+		states: make(map[Anchor]anchorVisitState)}
+	var anchors []Anchor
+
+	var collect func(*Area, string)
+	collect = func(area *Area, path string) {
+		for edge, anchor := range []Anchor{area.left, area.top, area.right, area.bottom} {
+			areaEdge := AreaEdge{Area: area, Edge: Edge(edge), Path: path}
+			validator.edges[anchor] = append(validator.edges[anchor], areaEdge)
+			anchors = append(anchors, anchor)
+		}
+		for index, child := range area.children {
+			collect(child, fmt.Sprintf("%s/%d", strings.TrimSuffix(path, "/"), index))
+		}
+	}
+	collect(root, "/")
+
+	for _, anchor := range anchors {
+		validator.visit(anchor)
+	}
+	if len(validator.cycles) > 0 {
+		return &AnchorCycleError{Cycles: validator.cycles}
+	}
+	return nil
+}
+
+func (validator *anchorValidator) visit(anchor Anchor) {
+	switch validator.states[anchor] {
+	case anchorVisiting:
+		validator.recordCycle(anchor)
+	case anchorUnvisited:
+		validator.states[anchor] = anchorVisiting
+		validator.stack = append(validator.stack, anchor)
+		for _, dependency := range AnchorDependencies(anchor) {
+			validator.visit(dependency)
+		}
+		validator.stack = validator.stack[:len(validator.stack)-1]
+		validator.states[anchor] = anchorVisited
+	}
+}
+
+func (validator *anchorValidator) recordCycle(start Anchor) {
+	var cycle AnchorCycle
+	startIndex := len(validator.stack) - 1
+
+	for validator.stack[startIndex] != start {
+		startIndex--
+	}
+	cycle.Anchors = append(cycle.Anchors, validator.stack[startIndex:]...)
+	for _, anchor := range cycle.Anchors {
+		cycle.Edges = append(cycle.Edges, validator.edges[anchor]...)
+	}
+	validator.cycles = append(validator.cycles, cycle)
+}
@@ -0,0 +1,55 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type AnchorValidatorSuite struct {
+}
+
+var _ = check.Suite(&AnchorValidatorSuite{})
+
+func (suite *AnchorValidatorSuite) TestValidTreeReturnsNoError(c *check.C) {
+	root := NewAreaBuilder().SetRight(NewAbsoluteAnchor(100.0)).Build()
+	builder := NewAreaBuilder().SetParent(root)
+	builder.SetLeft(NewOffsetAnchor(root.Left(), 10.0))
+	builder.SetRight(NewRelativeAnchor(root.Left(), root.Right(), 0.5))
+	builder.Build()
+
+	c.Check(ValidateAnchors(root), check.IsNil)
+}
+
+func (suite *AnchorValidatorSuite) TestCycleIsReportedWithParticipatingEdges(c *check.C) {
+	var right Anchor
+	root := NewAreaBuilder().Build()
+	left := NewOffsetAnchor(NewResolvingAnchor(func() Anchor { return right }), -10.0)
+	right = NewOffsetAnchor(left, 20.0)
+	builder := NewAreaBuilder().SetParent(root)
+	builder.SetLeft(left)
+	builder.SetRight(right)
+	child := builder.Build()
+
+	err := ValidateAnchors(root)
+
+	c.Assert(err, check.NotNil)
+	cycleError := err.(*AnchorCycleError)
+	c.Assert(len(cycleError.Cycles), check.Equals, 1)
+	c.Check(cycleError.Cycles[0].Edges, check.DeepEquals, []AreaEdge{
+		{Area: child, Edge: LeftEdge, Path: "/0"},
+		{Area: child, Edge: RightEdge, Path: "/0"}})
+	c.Check(err.Error(), check.Equals, "anchor cycles: 3 anchors, edges [/0.left, /0.right]")
+}
+
+func (suite *AnchorValidatorSuite) TestSelfReferenceIsReported(c *check.C) {
+	var top Anchor
+	root := NewAreaBuilder().Build()
+	top = NewResolvingAnchor(func() Anchor { return top })
+	builder := NewAreaBuilder().SetParent(root)
+	builder.SetTop(top)
+	builder.Build()
+
+	err := ValidateAnchors(root)
+
+	c.Assert(err, check.NotNil)
+	c.Check(err.Error(), check.Equals, "anchor cycles: 1 anchors, edges [/0.top]")
+}
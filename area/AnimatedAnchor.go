@@ -0,0 +1,39 @@
+package area
+
+import (
+	"time"
+
+	"github.com/dertseha/jellui/animation"
+)
+
+type animatedAnchor struct {
+	base     Anchor
+	animator *animation.Animator
+	duration time.Duration
+	easing   animation.Easing
+
+	tween *animation.Tween
+}
+
+// NewAnimatedAnchor returns an anchor that transitions the base anchor towards requested values.
+// Requests to set a new value start a transition from the current value, over given duration.
+// A running transition is replaced. The transition is driven by the animator.
+func NewAnimatedAnchor(base Anchor, animator *animation.Animator, duration time.Duration, easing animation.Easing) Anchor {
+	return &animatedAnchor{base: base, animator: animator, duration: duration, easing: easing}
+}
+
+func (anchor *animatedAnchor) Value() float32 {
+	return anchor.base.Value()
+}
+
+func (anchor *animatedAnchor) RequestValue(newValue float32) {
+	if anchor.tween != nil {
+		anchor.tween.Stop()
+	}
+	anchor.tween = anchor.animator.Tween([]float32{anchor.base.Value()}, []float32{newValue}, anchor.duration, anchor.easing,
+		func(values []float32) { anchor.base.RequestValue(values[0]) })
+}
+
+func (anchor *animatedAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.base}
+}
@@ -0,0 +1,47 @@
+package area
+
+import (
+	"time"
+
+	"github.com/dertseha/jellui/animation"
+
+	check "gopkg.in/check.v1"
+)
+
+type AnimatedAnchorSuite struct {
+	animator *animation.Animator
+}
+
+var _ = check.Suite(&AnimatedAnchorSuite{})
+
+func (suite *AnimatedAnchorSuite) SetUpTest(c *check.C) {
+	suite.animator = animation.NewAnimator()
+}
+
+func (suite *AnimatedAnchorSuite) TestValueReturnsValueOfBase(c *check.C) {
+	anchor := NewAnimatedAnchor(NewAbsoluteAnchor(10.0), suite.animator, 100*time.Millisecond, animation.Linear)
+
+	c.Check(anchor.Value(), check.Equals, float32(10.0))
+}
+
+func (suite *AnimatedAnchorSuite) TestRequestValueTransitionsOverTime(c *check.C) {
+	anchor := NewAnimatedAnchor(NewAbsoluteAnchor(10.0), suite.animator, 100*time.Millisecond, animation.Linear)
+
+	anchor.RequestValue(30.0)
+	c.Check(anchor.Value(), check.Equals, float32(10.0))
+	suite.animator.Tick(50 * time.Millisecond)
+	c.Check(anchor.Value(), check.Equals, float32(20.0))
+	suite.animator.Tick(50 * time.Millisecond)
+	c.Check(anchor.Value(), check.Equals, float32(30.0))
+}
+
+func (suite *AnimatedAnchorSuite) TestRequestValueReplacesRunningTransition(c *check.C) {
+	anchor := NewAnimatedAnchor(NewAbsoluteAnchor(0.0), suite.animator, 100*time.Millisecond, animation.Linear)
+
+	anchor.RequestValue(100.0)
+	suite.animator.Tick(50 * time.Millisecond)
+	anchor.RequestValue(0.0)
+	suite.animator.Tick(50 * time.Millisecond)
+
+	c.Check(anchor.Value(), check.Equals, float32(25.0))
+}
@@ -7,9 +7,24 @@ import (
 // RenderFunction is called when an area wants to render its content.
 type RenderFunction func(*Area)
 
+// BoundsChangeHandler is called when any edge of an area changed.
+type BoundsChangeHandler func(*Area)
+
 // EventHandler is called for events dispatched to the area.
 type EventHandler func(*Area, events.Event) bool
 
+// Subscription identifies an event handler registered with an area.
+type Subscription struct {
+	eventType events.EventType
+	handler   EventHandler
+}
+
+// PhasedSubscription identifies a capture or bubble handler registered with an area.
+type PhasedSubscription struct {
+	eventType events.EventType
+	handler   PhasedEventHandler
+}
+
 // Area specifies one rectangular area within the user-interface stack.
 type Area struct {
 	parent   *Area
@@ -22,26 +37,59 @@ type Area struct {
 	right  Anchor
 	bottom Anchor
 
-	visible bool
-
-	onRender     RenderFunction
-	eventHandler map[events.EventType]EventHandler
+	visible   bool
+	focusable bool
+	modal     bool
+	tabIndex  int
+	zIndex    int
+	tooltip   string
+
+	clipChildren bool
+	clipper      Clipper
+
+	onRender       RenderFunction
+	onBoundsChange BoundsChangeHandler
+	lastBounds     [4]float32
+	boundsKnown    bool
+	boundsDirty    bool
+	stopObserving  func()
+	eventHandler   map[events.EventType][]*Subscription
+	captureHandler map[events.EventType][]*PhasedSubscription
+	bubbleHandler  map[events.EventType][]*PhasedSubscription
 }
 
 // Remove removes the area from the parent.
 func (area *Area) Remove() {
 	area.ReleaseFocus()
+	area.stopObservingBounds()
 	if area.parent != nil {
 		area.parent.removeChild(area)
 		area.parent = nil
 	}
 }
 
+// stopObservingBounds ends the observation of the edges of this area and all children.
+// The observation is started again with the next rendering.
+func (area *Area) stopObservingBounds() {
+	if area.stopObserving != nil {
+		area.stopObserving()
+		area.stopObserving = nil
+	}
+	for _, child := range area.children {
+		child.stopObservingBounds()
+	}
+}
+
 // IsVisible returns true if the area is currently visible.
 func (area *Area) IsVisible() bool {
 	return area.visible
 }
 
+// IsShown returns true if the area and all of its ancestors are visible.
+func (area *Area) IsShown() bool {
+	return area.IsVisible() && ((area.parent == nil) || area.parent.IsShown())
+}
+
 // SetVisible determines whether the area (and all of its children) shall
 // be visible and target for events.
 // Invisible areas are not rendered and will not handle any events.
@@ -52,6 +100,31 @@ func (area *Area) SetVisible(visible bool) {
 	}
 }
 
+// IsFocusable returns true if the area can receive the focus by keyboard navigation.
+func (area *Area) IsFocusable() bool {
+	return area.focusable
+}
+
+// IsModal returns true if the area restricts keyboard navigation to itself and its children.
+func (area *Area) IsModal() bool {
+	return area.modal
+}
+
+// TabIndex returns the position of the area in the keyboard navigation order.
+func (area *Area) TabIndex() int {
+	return area.tabIndex
+}
+
+// Tooltip returns the explanatory text of the area. An empty text means the area has no tooltip.
+func (area *Area) Tooltip() string {
+	return area.tooltip
+}
+
+// SetTooltip sets the explanatory text of the area. An empty text removes the tooltip.
+func (area *Area) SetTooltip(text string) {
+	area.tooltip = text
+}
+
 // Root returns the area at the base of the UI tree.
 func (area *Area) Root() (root *Area) {
 	root = area
@@ -61,6 +134,92 @@ func (area *Area) Root() (root *Area) {
 	return
 }
 
+// ZIndex returns the stacking level of the area among its siblings.
+func (area *Area) ZIndex() int {
+	return area.zIndex
+}
+
+// SetZIndex changes the stacking level of the area among its siblings.
+// Areas with a higher index are rendered after, and receive positional events
+// before, areas with lower index. The area is placed in front of its new level.
+func (area *Area) SetZIndex(value int) {
+	area.zIndex = value
+	if area.parent != nil {
+		area.parent.removeChild(area)
+		area.parent.insertChild(len(area.parent.children), area)
+	}
+}
+
+// BringToFront moves the area in front of all siblings with the same z-index.
+func (area *Area) BringToFront() {
+	if area.parent != nil {
+		area.parent.removeChild(area)
+		area.parent.insertChild(len(area.parent.children), area)
+	}
+}
+
+// SendToBack moves the area behind all siblings with the same z-index.
+func (area *Area) SendToBack() {
+	if area.parent != nil {
+		area.parent.removeChild(area)
+		area.parent.insertChild(0, area)
+	}
+}
+
+// InsertChildAt inserts given area as a child at given index. An area that is a child
+// of another area is moved. The index is limited to the range of children with the same z-index
+// as the inserted area.
+// Inserting an area into itself, or into one of its children, causes a panic.
+func (area *Area) InsertChildAt(index int, child *Area) {
+	for ancestor := area; ancestor != nil; ancestor = ancestor.parent {
+		if ancestor == child {
+			panic("area: can not insert an area into itself")
+		}
+	}
+	if child.parent == area {
+		area.removeChild(child)
+	} else {
+		child.Remove()
+	}
+	area.insertChild(index, child)
+}
+
+// MoveTo moves the area to the front of the children of given parent.
+// Moved to a different parent, the area loses its focus. A nil parent makes the area a root area.
+func (area *Area) MoveTo(newParent *Area) {
+	if newParent != nil {
+		newParent.InsertChildAt(len(newParent.children), area)
+	} else {
+		area.Remove()
+	}
+}
+
+func (area *Area) insertChild(index int, child *Area) {
+	from, to := area.zIndexRange(child.zIndex)
+
+	if index < from {
+		index = from
+	} else if index > to {
+		index = to
+	}
+	area.children = append(area.children, nil)
+	copy(area.children[index+1:], area.children[index:])
+	area.children[index] = child
+	child.parent = area
+}
+
+// zIndexRange returns the range of indices in which a child of given z-index can be inserted.
+func (area *Area) zIndexRange(zIndex int) (from, to int) {
+	for (from < len(area.children)) && (area.children[from].zIndex < zIndex) {
+		from++
+	}
+	to = from
+	for (to < len(area.children)) && (area.children[to].zIndex <= zIndex) {
+		to++
+	}
+	return
+}
+
 func (area *Area) isChild(other *Area) (result bool) {
 	for _, child := range area.children {
 		if child == other {
@@ -87,6 +246,15 @@ func (area *Area) currentChildren() []*Area {
 	return children
 }
 
+// path returns the list of areas from the root to this area.
+// A nil area has an empty path.
+func (area *Area) path() (result []*Area) {
+	if area != nil {
+		result = append(area.parent.path(), area)
+	}
+	return
+}
+
 func (area *Area) isRoot() bool {
 	return area.parent == nil
 }
@@ -111,22 +279,93 @@ func (area *Area) Bottom() Anchor {
 	return area.bottom
 }
 
+// Width returns a virtual anchor for the width. Requests to set a new value move the right anchor.
+func (area *Area) Width() Anchor {
+	return NewExtentAnchor(area.left, area.right)
+}
+
+// Height returns a virtual anchor for the height. Requests to set a new value move the bottom anchor.
+func (area *Area) Height() Anchor {
+	return NewExtentAnchor(area.top, area.bottom)
+}
+
 // Render first renders this area, then sequentially all children.
+// With the first rendering, an area with a bounds change handler starts observing the anchors
+// its edges depend on. The handler is called before the first rendering, and before any later
+// rendering after a change was requested on one of these anchors that moved an edge.
+// Changes are only reported by anchors of this package, which includes observable anchors.
+// Areas that clip their children restrict the rendering of the children to their own
+// rectangle, intersected with the clip rectangle of any ancestor. Clipping requires
+// the root area to have a clipper.
 func (area *Area) Render() {
+	area.render(area.Root().clipper, nil)
+}
+
+func (area *Area) checkBounds() {
+	if area.stopObserving == nil {
+		area.stopObserving = observeChanges([]Anchor{area.left, area.top, area.right, area.bottom},
+			func() { area.boundsDirty = true })
+		area.boundsDirty = true
+	}
+	if area.boundsDirty {
+		currentBounds := [4]float32{area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value()}
+
+		area.boundsDirty = false
+		if !area.boundsKnown || (currentBounds != area.lastBounds) {
+			area.lastBounds = currentBounds
+			area.boundsKnown = true
+			area.onBoundsChange(area)
+		}
+	}
+}
+
+func (area *Area) render(clipper Clipper, clip *clipRectangle) {
 	if area.IsVisible() {
+		childClip := clip
+
+		if area.onBoundsChange != nil {
+			area.checkBounds()
+		}
 		area.onRender(area)
+		if area.clipChildren && (clipper != nil) {
+			ownClip := clipRectangle{
+				left:   area.Left().Value(),
+				top:    area.Top().Value(),
+				right:  area.Right().Value(),
+				bottom: area.Bottom().Value()}.intersect(clip)
+			childClip = &ownClip
+			clipper.ClipTo(childClip.left, childClip.top, childClip.right, childClip.bottom)
+		}
 		for _, child := range area.children {
-			child.Render()
+			child.render(clipper, childClip)
+		}
+		if childClip != clip {
+			if clip != nil {
+				clipper.ClipTo(clip.left, clip.top, clip.right, clip.bottom)
+			} else {
+				clipper.Unclip()
+			}
 		}
 	}
 }
 
 // HandleEvent tries to process the given event.
-// It returns true if the area consumed the event.
+// The event is first passed through the capture handlers along the focus chain, from this area
+// to the innermost focused area (the target). Then the areas of the focus chain try to handle
+// the event, starting with the target. Finally, the event bubbles back from the target to this area.
+// It returns true if the area consumed the event, or if its propagation was stopped.
 func (area *Area) HandleEvent(event events.Event) (consumed bool) {
+	if area.IsVisible() {
+		consumed = area.propagate(event, area.focusTarget(), func() bool { return area.handleEvent(event) })
+	}
+
+	return
+}
+
+func (area *Area) handleEvent(event events.Event) (consumed bool) {
 	if area.IsVisible() {
 		if area.focusedArea != nil {
-			consumed = area.focusedArea.HandleEvent(event)
+			consumed = area.focusedArea.handleEvent(event)
 		}
 		if !consumed {
 			consumed = area.tryEventHandlerFor(event)
@@ -139,10 +378,22 @@ func (area *Area) HandleEvent(event events.Event) (consumed bool) {
 // DispatchPositionalEvent tries to find an event handler in this areas
 // UI tree at the position of the event. The event is tried depth-first,
 // before trying to handle it within this area.
+// As with HandleEvent(), the event is passed through capture and bubble handlers.
+// The target is the innermost focused area, or otherwise the area at the position of the event.
 func (area *Area) DispatchPositionalEvent(event events.PositionalEvent) (consumed bool) {
+	if area.IsVisible() {
+		x, y := event.Position()
+		consumed = area.propagate(event, area.positionalTarget(x, y),
+			func() bool { return area.dispatchPositionalEvent(event) })
+	}
+
+	return
+}
+
+func (area *Area) dispatchPositionalEvent(event events.PositionalEvent) (consumed bool) {
 	if area.IsVisible() {
 		if area.focusedArea != nil {
-			consumed = area.focusedArea.DispatchPositionalEvent(event)
+			consumed = area.focusedArea.dispatchPositionalEvent(event)
 		}
 		if !consumed {
 			children := area.currentChildren()
@@ -150,10 +401,8 @@ func (area *Area) DispatchPositionalEvent(event events.PositionalEvent) (consume
 
 			for childIndex := len(children) - 1; !consumed && (childIndex >= 0); childIndex-- {
 				child := children[childIndex]
-				if area.isChild(child) && (child != area.focusedArea) &&
-					(x >= child.Left().Value()) && (x < child.Right().Value()) &&
-					(y >= child.Top().Value()) && (y < child.Bottom().Value()) {
-					consumed = child.DispatchPositionalEvent(event)
+				if area.isChild(child) && (child != area.focusedArea) && child.Contains(x, y) {
+					consumed = child.dispatchPositionalEvent(event)
 				}
 			}
 		}
@@ -165,16 +414,199 @@ func (area *Area) DispatchPositionalEvent(event events.PositionalEvent) (consume
 	return
 }
 
+// propagate passes the event through the capture handlers from this area down to the target,
+// calls the given dispatch function, and then lets the event bubble from the target back to this area.
+func (area *Area) propagate(event events.Event, target *Area, dispatch func() bool) (consumed bool) {
+	path := target.pathFrom(area)
+	last := len(path) - 1
+	context := &EventContext{target: target}
+
+	for index := 0; (index < last) && !context.IsPropagationStopped(); index++ {
+		path[index].tryPhasedHandlerFor(context, CapturePhase, path[index].captureHandler, event)
+	}
+	if !context.IsPropagationStopped() {
+		target.tryPhasedHandlerFor(context, TargetPhase, target.captureHandler, event)
+	}
+	if !context.IsPropagationStopped() {
+		consumed = dispatch()
+		target.tryPhasedHandlerFor(context, TargetPhase, target.bubbleHandler, event)
+	}
+	for index := last - 1; (index >= 0) && !context.IsPropagationStopped(); index-- {
+		path[index].tryPhasedHandlerFor(context, BubblePhase, path[index].bubbleHandler, event)
+	}
+
+	return consumed || context.IsPropagationStopped()
+}
+
+func (area *Area) tryPhasedHandlerFor(context *EventContext, phase EventPhase,
+	handlers map[events.EventType][]*PhasedSubscription, event events.Event) {
+	subscriptions := handlers[event.EventType()]
+	currentSubscriptions := make([]*PhasedSubscription, len(subscriptions))
+
+	copy(currentSubscriptions, subscriptions)
+	for _, subscription := range currentSubscriptions {
+		if !context.IsPropagationStopped() {
+			context.current = area
+			context.phase = phase
+			subscription.handler(context, event)
+		}
+	}
+}
+
+// focusTarget returns the innermost area of the focus chain starting at this area.
+func (area *Area) focusTarget() (target *Area) {
+	target = area
+	for target.focusedArea != nil {
+		target = target.focusedArea
+	}
+	return
+}
+
+// positionalTarget returns the innermost area that is first offered an event at given position.
+// Focused areas take precedence over the position.
+func (area *Area) positionalTarget(x, y float32) (target *Area) {
+	target = area
+	for next := target.nextPositionalTarget(x, y); next != nil; next = target.nextPositionalTarget(x, y) {
+		target = next
+	}
+	return
+}
+
+func (area *Area) nextPositionalTarget(x, y float32) (next *Area) {
+	if area.focusedArea != nil {
+		next = area.focusedArea
+	}
+	for childIndex := len(area.children) - 1; (next == nil) && (childIndex >= 0); childIndex-- {
+		child := area.children[childIndex]
+		if child.IsVisible() && child.Contains(x, y) {
+			next = child
+		}
+	}
+	return
+}
+
+// pathFrom returns the list of areas from given ancestor to this area.
+func (area *Area) pathFrom(ancestor *Area) (result []*Area) {
+	result = []*Area{area}
+	if (area != ancestor) && (area.parent != nil) {
+		result = append(area.parent.pathFrom(ancestor), area)
+	}
+	return
+}
+
+// Contains returns true if the given position is within the bounds of the area.
+func (area *Area) Contains(x, y float32) bool {
+	return (x >= area.Left().Value()) && (x < area.Right().Value()) &&
+		(y >= area.Top().Value()) && (y < area.Bottom().Value())
+}
+
+// areaAt returns the deepest visible area at given position, starting with this area.
+// Returns nil if this area is not visible or does not contain the position.
+func (area *Area) areaAt(x, y float32) (result *Area) {
+	if area.IsVisible() && area.Contains(x, y) {
+		result = area
+		for childIndex := len(area.children) - 1; (result == area) && (childIndex >= 0); childIndex-- {
+			if found := area.children[childIndex].areaAt(x, y); found != nil {
+				result = found
+			}
+		}
+	}
+	return
+}
+
 func (area *Area) tryEventHandlerFor(event events.Event) (consumed bool) {
-	handler, existing := area.eventHandler[event.EventType()]
+	subscriptions := area.eventHandler[event.EventType()]
+	currentSubscriptions := make([]*Subscription, len(subscriptions))
 
-	if existing {
-		consumed = handler(area, event)
+	copy(currentSubscriptions, subscriptions)
+	for _, subscription := range currentSubscriptions {
+		if subscription.handler(area, event) {
+			consumed = true
+		}
 	}
 
 	return
 }
 
+// AddEventHandler registers given handler for given event type. Handlers of the same
+// type are called in order of registration; the event is consumed if any of them consumes it.
+// The returned subscription can be used to remove the handler again.
+func (area *Area) AddEventHandler(eventType events.EventType, handler EventHandler) *Subscription {
+	subscription := &Subscription{eventType: eventType, handler: handler}
+
+	area.eventHandler[eventType] = append(area.eventHandler[eventType], subscription)
+
+	return subscription
+}
+
+// RemoveEventHandler unregisters the handler of given subscription.
+// Removing an unknown subscription has no effect.
+func (area *Area) RemoveEventHandler(subscription *Subscription) {
+	subscriptions := area.eventHandler[subscription.eventType]
+	remaining := []*Subscription{}
+
+	for _, other := range subscriptions {
+		if other != subscription {
+			remaining = append(remaining, other)
+		}
+	}
+	if len(remaining) > 0 {
+		area.eventHandler[subscription.eventType] = remaining
+	} else {
+		delete(area.eventHandler, subscription.eventType)
+	}
+}
+
+// AddCaptureHandler registers given handler for given event type, which is called while the event travels
+// from the root towards its target. Handlers of the same type are called in order of registration.
+// The returned subscription can be used to remove the handler again.
+func (area *Area) AddCaptureHandler(eventType events.EventType, handler PhasedEventHandler) *PhasedSubscription {
+	return addPhasedHandler(area.captureHandler, eventType, handler)
+}
+
+// RemoveCaptureHandler unregisters the capture handler of given subscription.
+// Removing an unknown subscription has no effect.
+func (area *Area) RemoveCaptureHandler(subscription *PhasedSubscription) {
+	removePhasedHandler(area.captureHandler, subscription)
+}
+
+// AddBubbleHandler registers given handler for given event type, which is called after the event was
+// dispatched to its target, while travelling back to the root. Handlers of the same type are called
+// in order of registration. The returned subscription can be used to remove the handler again.
+func (area *Area) AddBubbleHandler(eventType events.EventType, handler PhasedEventHandler) *PhasedSubscription {
+	return addPhasedHandler(area.bubbleHandler, eventType, handler)
+}
+
+// RemoveBubbleHandler unregisters the bubble handler of given subscription.
+// Removing an unknown subscription has no effect.
+func (area *Area) RemoveBubbleHandler(subscription *PhasedSubscription) {
+	removePhasedHandler(area.bubbleHandler, subscription)
+}
+
+func addPhasedHandler(handlers map[events.EventType][]*PhasedSubscription,
+	eventType events.EventType, handler PhasedEventHandler) *PhasedSubscription {
+	subscription := &PhasedSubscription{eventType: eventType, handler: handler}
+
+	handlers[eventType] = append(handlers[eventType], subscription)
+
+	return subscription
+}
+
+func removePhasedHandler(handlers map[events.EventType][]*PhasedSubscription, subscription *PhasedSubscription) {
+	remaining := []*PhasedSubscription{}
+
+	for _, other := range handlers[subscription.eventType] {
+		if other != subscription {
+			remaining = append(remaining, other)
+		}
+	}
+	if len(remaining) > 0 {
+		handlers[subscription.eventType] = remaining
+	} else {
+		delete(handlers, subscription.eventType)
+	}
+}
+
 // HasFocus returns true if this area (or any child) currently has the focus.
 // The root area always has focus.
 func (area *Area) HasFocus() bool {
@@ -13,10 +13,23 @@ type AreaBuilder struct {
 	right  Anchor
 	bottom Anchor
 
-	visible bool
-
-	onRender     RenderFunction
-	eventHandler map[events.EventType]EventHandler
+	sizeConstraints SizeConstraints
+
+	visible   bool
+	focusable bool
+	modal     bool
+	tabIndex  int
+	zIndex    int
+	tooltip   string
+
+	clipChildren bool
+	clipper      Clipper
+
+	onRender       RenderFunction
+	onBoundsChange BoundsChangeHandler
+	eventHandler   map[events.EventType][]EventHandler
+	captureHandler map[events.EventType][]PhasedEventHandler
+	bubbleHandler  map[events.EventType][]PhasedEventHandler
 }
 
 // NewAreaBuilder returns a new instance of a builder for creating areas.
@@ -29,32 +42,58 @@ func NewAreaBuilder() *AreaBuilder {
 
 		visible: true,
 
-		onRender:     func(*Area) {},
-		eventHandler: make(map[events.EventType]EventHandler)}
+		onRender:       func(*Area) {},
+		eventHandler:   make(map[events.EventType][]EventHandler),
+		captureHandler: make(map[events.EventType][]PhasedEventHandler),
+		bubbleHandler:  make(map[events.EventType][]PhasedEventHandler)}
 
 	return builder
 }
 
 // Build creates a new area instance based on the currently set properties.
 func (builder *AreaBuilder) Build() *Area {
+	right, bottom := builder.sizeConstraints.apply(builder.left, builder.top, builder.right, builder.bottom)
 	area := &Area{
 		parent: builder.parent,
 
 		left:   builder.left,
 		top:    builder.top,
-		right:  builder.right,
-		bottom: builder.bottom,
-
-		visible: builder.visible,
-
-		onRender:     builder.onRender,
-		eventHandler: make(map[events.EventType]EventHandler)}
-
-	for key, handler := range builder.eventHandler {
-		area.eventHandler[key] = handler
+		right:  right,
+		bottom: bottom,
+
+		visible:   builder.visible,
+		focusable: builder.focusable,
+		modal:     builder.modal,
+		tabIndex:  builder.tabIndex,
+		zIndex:    builder.zIndex,
+		tooltip:   builder.tooltip,
+
+		clipChildren: builder.clipChildren,
+		clipper:      builder.clipper,
+
+		onRender:       builder.onRender,
+		onBoundsChange: builder.onBoundsChange,
+		eventHandler:   make(map[events.EventType][]*Subscription),
+		captureHandler: make(map[events.EventType][]*PhasedSubscription),
+		bubbleHandler:  make(map[events.EventType][]*PhasedSubscription)}
+
+	for eventType, handlers := range builder.eventHandler {
+		for _, handler := range handlers {
+			area.AddEventHandler(eventType, handler)
+		}
+	}
+	for eventType, handlers := range builder.captureHandler {
+		for _, handler := range handlers {
+			area.AddCaptureHandler(eventType, handler)
+		}
+	}
+	for eventType, handlers := range builder.bubbleHandler {
+		for _, handler := range handlers {
+			area.AddBubbleHandler(eventType, handler)
+		}
 	}
 	if area.parent != nil {
-		area.parent.children = append(area.parent.children, area)
+		area.parent.insertChild(len(area.parent.children), area)
 	}
 
 	return area
@@ -91,12 +130,70 @@ func (builder *AreaBuilder) SetBottom(value Anchor) *AreaBuilder {
 	return builder
 }
 
+// SetSizeConstraints sets limits for the size of the area. The constraints are applied
+// to the right and bottom anchors, relative to the left and top anchors. Default: no constraints
+func (builder *AreaBuilder) SetSizeConstraints(value SizeConstraints) *AreaBuilder {
+	builder.sizeConstraints = value
+	return builder
+}
+
 // SetVisible sets the initial visibility.
 func (builder *AreaBuilder) SetVisible(value bool) *AreaBuilder {
 	builder.visible = value
 	return builder
 }
 
+// SetFocusable determines whether the area can receive the focus by keyboard navigation.
+// Default: false
+func (builder *AreaBuilder) SetFocusable(value bool) *AreaBuilder {
+	builder.focusable = value
+	return builder
+}
+
+// SetModal determines whether the area restricts keyboard navigation to itself and its children,
+// for as long as it is visible. Default: false
+func (builder *AreaBuilder) SetModal(value bool) *AreaBuilder {
+	builder.modal = value
+	return builder
+}
+
+// SetTabIndex sets the position of the area in the keyboard navigation order.
+// Areas with lower index are visited first, areas with equal index in order of the tree.
+// Default: 0
+func (builder *AreaBuilder) SetTabIndex(value int) *AreaBuilder {
+	builder.tabIndex = value
+	return builder
+}
+
+// SetTooltip sets the explanatory text that is shown while the cursor rests on the area.
+// Default: ""
+func (builder *AreaBuilder) SetTooltip(text string) *AreaBuilder {
+	builder.tooltip = text
+	return builder
+}
+
+// SetZIndex sets the stacking level among the siblings. Areas with a higher index
+// are rendered after, and receive positional events before, areas with lower index.
+// Default: 0
+func (builder *AreaBuilder) SetZIndex(value int) *AreaBuilder {
+	builder.zIndex = value
+	return builder
+}
+
+// SetClipChildren determines whether the rendering of the children shall be restricted
+// to the rectangle of the area. Default: false
+func (builder *AreaBuilder) SetClipChildren(value bool) *AreaBuilder {
+	builder.clipChildren = value
+	return builder
+}
+
+// SetClipper sets the clipper to use for rendering. It is only used for root areas.
+// Without clipper, children are not clipped. Default: nil
+func (builder *AreaBuilder) SetClipper(clipper Clipper) *AreaBuilder {
+	builder.clipper = clipper
+	return builder
+}
+
 // OnRender sets the function for rendering the area.
 // By default, an area has no own presentation.
 func (builder *AreaBuilder) OnRender(render RenderFunction) *AreaBuilder {
@@ -104,8 +201,38 @@ func (builder *AreaBuilder) OnRender(render RenderFunction) *AreaBuilder {
 	return builder
 }
 
-// OnEvent sets an event handler for given event type.
+// OnBoundsChange sets the function to be called when any edge of the area changed.
+// Changes are detected before rendering the area, see Area.Render().
+func (builder *AreaBuilder) OnBoundsChange(handler BoundsChangeHandler) *AreaBuilder {
+	builder.onBoundsChange = handler
+	return builder
+}
+
+// OnEvent sets an event handler for given event type. Any handler previously set or added
+// for the same type is replaced.
 func (builder *AreaBuilder) OnEvent(eventType events.EventType, handler EventHandler) *AreaBuilder {
-	builder.eventHandler[eventType] = handler
+	builder.eventHandler[eventType] = []EventHandler{handler}
+	return builder
+}
+
+// AddEventHandler adds an event handler for given event type, keeping any previous ones.
+// Several handlers for the same type are called in order they were added.
+func (builder *AreaBuilder) AddEventHandler(eventType events.EventType, handler EventHandler) *AreaBuilder {
+	builder.eventHandler[eventType] = append(builder.eventHandler[eventType], handler)
+	return builder
+}
+
+// OnCapture adds a handler for given event type, which is called while the event travels
+// from the root towards its target. This allows an area to intercept events meant for its children.
+func (builder *AreaBuilder) OnCapture(eventType events.EventType, handler PhasedEventHandler) *AreaBuilder {
+	builder.captureHandler[eventType] = append(builder.captureHandler[eventType], handler)
+	return builder
+}
+
+// OnBubble adds a handler for given event type, which is called after the event was
+// dispatched to its target, while travelling back to the root. The handler is called
+// regardless of whether the event was consumed.
+func (builder *AreaBuilder) OnBubble(eventType events.EventType, handler PhasedEventHandler) *AreaBuilder {
+	builder.bubbleHandler[eventType] = append(builder.bubbleHandler[eventType], handler)
 	return builder
 }
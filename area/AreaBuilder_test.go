@@ -1,6 +1,8 @@
 package area
 
 import (
+	"github.com/dertseha/jellui/area/events"
+
 	check "gopkg.in/check.v1"
 )
 
@@ -58,3 +60,61 @@ func (suite *AreaBuilderSuite) TestOnRenderSetsRenderFunction(c *check.C) {
 
 	c.Check(called, check.Equals, true)
 }
+
+func (suite *AreaBuilderSuite) TestAddEventHandlerKeepsAllHandlersInOrder(c *check.C) {
+	var calls []int
+	eventType := events.EventType("testEvent")
+	aHandler := func(index int) EventHandler {
+		return func(*Area, events.Event) bool {
+			calls = append(calls, index)
+			return false
+		}
+	}
+	suite.builder.OnEvent(eventType, aHandler(1))
+	suite.builder.AddEventHandler(eventType, aHandler(2))
+	area := suite.builder.Build()
+
+	area.HandleEvent(&testingEvent{eventType})
+
+	c.Check(calls, check.DeepEquals, []int{1, 2})
+}
+
+func (suite *AreaBuilderSuite) TestOnEventReplacesHandlerForFurtherAreas(c *check.C) {
+	var calls []int
+	eventType := events.EventType("testEvent")
+	aHandler := func(index int) EventHandler {
+		return func(*Area, events.Event) bool {
+			calls = append(calls, index)
+			return false
+		}
+	}
+	suite.builder.OnEvent(eventType, aHandler(1))
+	suite.builder.Build()
+	suite.builder.OnEvent(eventType, aHandler(2))
+	area := suite.builder.Build()
+
+	area.HandleEvent(&testingEvent{eventType})
+
+	c.Check(calls, check.DeepEquals, []int{2})
+}
+
+func (suite *AreaBuilderSuite) TestSizeConstraintsLimitWidthAndHeight(c *check.C) {
+	suite.builder.SetLeft(NewAbsoluteAnchor(10.0))
+	suite.builder.SetTop(NewAbsoluteAnchor(20.0))
+	suite.builder.SetRight(NewAbsoluteAnchor(500.0))
+	suite.builder.SetBottom(NewAbsoluteAnchor(25.0))
+	suite.builder.SetSizeConstraints(SizeConstraints{MinWidth: 80.0, MaxWidth: 300.0, MinHeight: 30.0})
+	area := suite.builder.Build()
+
+	c.Check(area.Right().Value(), check.Equals, float32(310.0))
+	c.Check(area.Bottom().Value(), check.Equals, float32(50.0))
+}
+
+func (suite *AreaBuilderSuite) TestSizeConstraintsKeepAspectRatio(c *check.C) {
+	suite.builder.SetRight(NewAbsoluteAnchor(500.0))
+	suite.builder.SetSizeConstraints(SizeConstraints{MaxWidth: 320.0, AspectRatio: 16.0 / 9.0})
+	area := suite.builder.Build()
+
+	c.Check(area.Right().Value(), check.Equals, float32(320.0))
+	c.Check(area.Bottom().Value(), check.Equals, float32(180.0))
+}
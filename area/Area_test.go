@@ -1,6 +1,8 @@
 package area
 
 import (
+	"fmt"
+
 	"github.com/dertseha/jellui/area/events"
 
 	check "gopkg.in/check.v1"
@@ -155,6 +157,16 @@ func (suite *AreaSuite) TestDispatchPositionalEventCallsChildrenAtPositionHighes
 	c.Check(handleSequence, check.DeepEquals, []int{2, 1, 0})
 }
 
+func (suite *AreaSuite) TestContainsIncludesLeftTopAndExcludesRightBottom(c *check.C) {
+	area := suite.builder.Build()
+
+	c.Check(area.Contains(0.0, 0.0), check.Equals, true)
+	c.Check(area.Contains(99.5, 99.5), check.Equals, true)
+	c.Check(area.Contains(100.0, 50.0), check.Equals, false)
+	c.Check(area.Contains(50.0, 100.0), check.Equals, false)
+	c.Check(area.Contains(-0.5, 50.0), check.Equals, false)
+}
+
 func (suite *AreaSuite) TestRootReturnsRootArea(c *check.C) {
 	area := suite.builder.Build()
 	var subArea *Area
@@ -594,3 +606,412 @@ func (suite *AreaSuite) TestInvisibleAreaLosesFocus(c *check.C) {
 
 	c.Check(subArea.HasFocus(), check.Equals, false)
 }
+
+func (suite *AreaSuite) TestAreaOfInvisibleParentIsNotShown(c *check.C) {
+	parent := suite.builder.Build()
+	subArea := NewAreaBuilder().SetParent(parent).Build()
+
+	c.Check(subArea.IsShown(), check.Equals, true)
+	parent.SetVisible(false)
+	c.Check(subArea.IsVisible(), check.Equals, true)
+	c.Check(subArea.IsShown(), check.Equals, false)
+}
+
+func (suite *AreaSuite) aPhaseRecorder(record *[]string, id string) PhasedEventHandler {
+	return func(context *EventContext, event events.Event) {
+		phaseNames := map[EventPhase]string{CapturePhase: "capture", TargetPhase: "target", BubblePhase: "bubble"}
+		*record = append(*record, phaseNames[context.Phase()]+" "+id)
+	}
+}
+
+func (suite *AreaSuite) aPhaseRecordingArea(parent *Area, record *[]string, id string, eventType events.EventType) *Area {
+	builder := NewAreaBuilder()
+	builder.SetParent(parent)
+	builder.SetRight(NewAbsoluteAnchor(100.0))
+	builder.SetBottom(NewAbsoluteAnchor(100.0))
+	builder.OnCapture(eventType, suite.aPhaseRecorder(record, id))
+	builder.OnBubble(eventType, suite.aPhaseRecorder(record, id))
+	builder.OnEvent(eventType, func(*Area, events.Event) bool {
+		*record = append(*record, "handle "+id)
+		return true
+	})
+	return builder.Build()
+}
+
+func (suite *AreaSuite) TestHandleEventPassesCaptureAndBubblePhasesAlongFocusChain(c *check.C) {
+	var record []string
+	eventType := events.EventType("phasedEvent")
+	root := suite.aPhaseRecordingArea(nil, &record, "root", eventType)
+	child := suite.aPhaseRecordingArea(root, &record, "child", eventType)
+	grandchild := suite.aPhaseRecordingArea(child, &record, "grandchild", eventType)
+	grandchild.RequestFocus()
+
+	root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(record, check.DeepEquals, []string{
+		"capture root", "capture child", "target grandchild",
+		"handle grandchild",
+		"target grandchild", "bubble child", "bubble root"})
+}
+
+func (suite *AreaSuite) TestDispatchPositionalEventPassesPhasesAlongAreasAtPosition(c *check.C) {
+	var record []string
+	eventType := events.EventType("test.positional")
+	root := suite.aPhaseRecordingArea(nil, &record, "root", eventType)
+	suite.aPhaseRecordingArea(root, &record, "child", eventType)
+
+	root.DispatchPositionalEvent(suite.aPositionalEvent(10.0, 10.0))
+
+	c.Check(record, check.DeepEquals, []string{
+		"capture root", "target child", "handle child", "target child", "bubble root"})
+}
+
+func (suite *AreaSuite) TestEventContextProvidesTargetAndCurrentArea(c *check.C) {
+	eventType := events.EventType("phasedEvent")
+	var targets, currents []*Area
+	recorder := func(context *EventContext, event events.Event) {
+		targets = append(targets, context.Target())
+		currents = append(currents, context.Current())
+	}
+	root := NewAreaBuilder().OnBubble(eventType, recorder).Build()
+	child := NewAreaBuilder().SetParent(root).OnBubble(eventType, recorder).Build()
+	child.RequestFocus()
+
+	root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(targets, check.DeepEquals, []*Area{child, child})
+	c.Check(currents, check.DeepEquals, []*Area{child, root})
+}
+
+func (suite *AreaSuite) TestStopPropagationInCapturePhaseInterceptsEvent(c *check.C) {
+	var record []string
+	eventType := events.EventType("phasedEvent")
+	root := NewAreaBuilder().OnCapture(eventType, func(context *EventContext, event events.Event) {
+		context.StopPropagation()
+	}).Build()
+	child := suite.aPhaseRecordingArea(root, &record, "child", eventType)
+	child.RequestFocus()
+
+	consumed := root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(consumed, check.Equals, true)
+	c.Check(record, check.IsNil)
+}
+
+func (suite *AreaSuite) TestStopPropagationInBubblePhaseStopsFurtherBubbling(c *check.C) {
+	var record []string
+	eventType := events.EventType("phasedEvent")
+	root := suite.aPhaseRecordingArea(nil, &record, "root", eventType)
+	child := NewAreaBuilder().SetParent(root).OnBubble(eventType, func(context *EventContext, event events.Event) {
+		context.StopPropagation()
+	}).Build()
+	grandchild := NewAreaBuilder().SetParent(child).Build()
+	grandchild.RequestFocus()
+
+	root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(record, check.DeepEquals, []string{"capture root", "handle root"})
+}
+
+func (suite *AreaSuite) TestBubblePhaseObservesConsumedEvents(c *check.C) {
+	eventType := events.EventType("phasedEvent")
+	observed := false
+	root := NewAreaBuilder().OnBubble(eventType, func(context *EventContext, event events.Event) {
+		observed = true
+	}).Build()
+	child := NewAreaBuilder().SetParent(root).OnEvent(eventType, SilentConsumer).Build()
+	child.RequestFocus()
+
+	consumed := root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(consumed, check.Equals, true)
+	c.Check(observed, check.Equals, true)
+}
+
+func (suite *AreaSuite) TestAddEventHandlerAddsHandlerAfterBuild(c *check.C) {
+	eventType := events.EventType("registeredEvent")
+	var calls []string
+	suite.builder.OnEvent(eventType, func(*Area, events.Event) bool {
+		calls = append(calls, "builder")
+		return false
+	})
+	area := suite.builder.Build()
+	area.AddEventHandler(eventType, func(*Area, events.Event) bool {
+		calls = append(calls, "added")
+		return true
+	})
+
+	consumed := area.HandleEvent(&testingEvent{eventType})
+
+	c.Check(calls, check.DeepEquals, []string{"builder", "added"})
+	c.Check(consumed, check.Equals, true)
+}
+
+func (suite *AreaSuite) TestRemoveEventHandlerRemovesOnlyGivenSubscription(c *check.C) {
+	eventType := events.EventType("registeredEvent")
+	var calls []string
+	area := suite.builder.Build()
+	first := area.AddEventHandler(eventType, func(*Area, events.Event) bool {
+		calls = append(calls, "first")
+		return false
+	})
+	area.AddEventHandler(eventType, func(*Area, events.Event) bool {
+		calls = append(calls, "second")
+		return false
+	})
+
+	area.RemoveEventHandler(first)
+	area.HandleEvent(&testingEvent{eventType})
+
+	c.Check(calls, check.DeepEquals, []string{"second"})
+}
+
+func (suite *AreaSuite) TestRemoveEventHandlerDuringDispatchDoesNotSkipOthers(c *check.C) {
+	eventType := events.EventType("registeredEvent")
+	var calls []string
+	var first *Subscription
+	area := suite.builder.Build()
+	first = area.AddEventHandler(eventType, func(area *Area, event events.Event) bool {
+		calls = append(calls, "first")
+		area.RemoveEventHandler(first)
+		return false
+	})
+	area.AddEventHandler(eventType, func(*Area, events.Event) bool {
+		calls = append(calls, "second")
+		return false
+	})
+
+	area.HandleEvent(&testingEvent{eventType})
+	area.HandleEvent(&testingEvent{eventType})
+
+	c.Check(calls, check.DeepEquals, []string{"first", "second", "second"})
+}
+
+func (suite *AreaSuite) aChildWithZIndex(parent *Area, zIndex int) *Area {
+	return NewAreaBuilder().SetParent(parent).SetZIndex(zIndex).Build()
+}
+
+func (suite *AreaSuite) TestChildrenAreOrderedByZIndex(c *check.C) {
+	parent := suite.builder.Build()
+	high := suite.aChildWithZIndex(parent, 10)
+	low1 := suite.aChildWithZIndex(parent, 0)
+	low2 := suite.aChildWithZIndex(parent, 0)
+
+	c.Check(parent.children, check.DeepEquals, []*Area{low1, low2, high})
+}
+
+func (suite *AreaSuite) TestBringToFrontMovesAreaToEndOfItsLevel(c *check.C) {
+	parent := suite.builder.Build()
+	first := suite.aChildWithZIndex(parent, 0)
+	second := suite.aChildWithZIndex(parent, 0)
+	high := suite.aChildWithZIndex(parent, 1)
+
+	first.BringToFront()
+
+	c.Check(parent.children, check.DeepEquals, []*Area{second, first, high})
+}
+
+func (suite *AreaSuite) TestSendToBackMovesAreaToStartOfItsLevel(c *check.C) {
+	parent := suite.builder.Build()
+	low := suite.aChildWithZIndex(parent, -1)
+	first := suite.aChildWithZIndex(parent, 0)
+	second := suite.aChildWithZIndex(parent, 0)
+
+	second.SendToBack()
+
+	c.Check(parent.children, check.DeepEquals, []*Area{low, second, first})
+}
+
+func (suite *AreaSuite) TestSetZIndexReordersArea(c *check.C) {
+	parent := suite.builder.Build()
+	first := suite.aChildWithZIndex(parent, 0)
+	second := suite.aChildWithZIndex(parent, 0)
+
+	first.SetZIndex(5)
+
+	c.Check(parent.children, check.DeepEquals, []*Area{second, first})
+}
+
+func (suite *AreaSuite) TestInsertChildAtInsertsAtIndexWithinLevel(c *check.C) {
+	parent := suite.builder.Build()
+	first := suite.aChildWithZIndex(parent, 0)
+	second := suite.aChildWithZIndex(parent, 0)
+	high := suite.aChildWithZIndex(parent, 1)
+	other := NewAreaBuilder().Build()
+
+	parent.InsertChildAt(1, other)
+	c.Check(parent.children, check.DeepEquals, []*Area{first, other, second, high})
+	c.Check(other.parent, check.Equals, parent)
+
+	parent.InsertChildAt(10, other)
+	c.Check(parent.children, check.DeepEquals, []*Area{first, second, other, high})
+}
+
+func (suite *AreaSuite) TestInsertChildAtPanicsForAncestor(c *check.C) {
+	parent := suite.builder.Build()
+	child := suite.aChildWithZIndex(parent, 0)
+
+	c.Check(func() { child.InsertChildAt(0, parent) }, check.Panics, "area: can not insert an area into itself")
+}
+
+func (suite *AreaSuite) TestMoveToReparentsArea(c *check.C) {
+	root := suite.builder.Build()
+	oldParent := suite.aChildWithZIndex(root, 0)
+	newParent := suite.aChildWithZIndex(root, 0)
+	moved := suite.aChildWithZIndex(oldParent, 0)
+	moved.RequestFocus()
+
+	moved.MoveTo(newParent)
+
+	c.Check(oldParent.children, check.DeepEquals, []*Area{})
+	c.Check(newParent.children, check.DeepEquals, []*Area{moved})
+	c.Check(moved.Root(), check.Equals, root)
+	c.Check(moved.HasFocus(), check.Equals, false)
+}
+
+func (suite *AreaSuite) TestDispatchPositionalEventHonorsZIndex(c *check.C) {
+	testEvent := suite.aPositionalEvent(50.0, 50.0)
+	var called []string
+	parent := suite.builder.Build()
+	aSubArea := func(name string, zIndex int) {
+		builder := NewAreaBuilder().SetParent(parent).SetZIndex(zIndex)
+		builder.SetRight(NewAbsoluteAnchor(100.0))
+		builder.SetBottom(NewAbsoluteAnchor(100.0))
+		builder.OnEvent(testEvent.EventType(), func(*Area, events.Event) bool {
+			called = append(called, name)
+			return true
+		})
+		builder.Build()
+	}
+	aSubArea("overlay", 1)
+	aSubArea("normal", 0)
+
+	parent.DispatchPositionalEvent(testEvent)
+
+	c.Check(called, check.DeepEquals, []string{"overlay"})
+}
+
+type recordingClipper struct {
+	calls []string
+}
+
+func (clipper *recordingClipper) ClipTo(left, top, right, bottom float32) {
+	clipper.calls = append(clipper.calls, fmt.Sprintf("clip %v %v %v %v", left, top, right, bottom))
+}
+
+func (clipper *recordingClipper) Unclip() {
+	clipper.calls = append(clipper.calls, "unclip")
+}
+
+func (suite *AreaSuite) aClippingArea(parent *Area, left, top, right, bottom float32, clipper *recordingClipper) *Area {
+	builder := NewAreaBuilder()
+	builder.SetParent(parent)
+	builder.SetLeft(NewAbsoluteAnchor(left))
+	builder.SetTop(NewAbsoluteAnchor(top))
+	builder.SetRight(NewAbsoluteAnchor(right))
+	builder.SetBottom(NewAbsoluteAnchor(bottom))
+	builder.SetClipChildren(true)
+	builder.OnRender(func(area *Area) {
+		clipper.calls = append(clipper.calls, fmt.Sprintf("render %v", area.Left().Value()))
+	})
+	return builder.Build()
+}
+
+func (suite *AreaSuite) TestRenderClipsChildrenOfClippingArea(c *check.C) {
+	clipper := &recordingClipper{}
+	root := suite.builder.SetClipper(clipper).Build()
+	outer := suite.aClippingArea(root, 10.0, 10.0, 50.0, 50.0, clipper)
+	suite.aClippingArea(outer, 30.0, 0.0, 80.0, 40.0, clipper)
+
+	root.Render()
+
+	c.Check(clipper.calls, check.DeepEquals, []string{
+		"render 10", "clip 10 10 50 50",
+		"render 30", "clip 30 10 50 40",
+		"clip 10 10 50 50",
+		"unclip"})
+}
+
+func (suite *AreaSuite) TestRenderDoesNotClipWithoutClipper(c *check.C) {
+	clipper := &recordingClipper{}
+	root := suite.builder.Build()
+	suite.aClippingArea(root, 10.0, 10.0, 50.0, 50.0, clipper)
+
+	root.Render()
+
+	c.Check(clipper.calls, check.DeepEquals, []string{"render 10"})
+}
+
+func (suite *AreaSuite) TestRenderCallsBoundsChangeHandlerOnlyForChangedBounds(c *check.C) {
+	calls := 0
+	suite.builder.OnBoundsChange(func(*Area) { calls++ })
+	area := suite.builder.Build()
+
+	area.Render()
+	c.Check(calls, check.Equals, 1)
+	area.Render()
+	c.Check(calls, check.Equals, 1)
+	area.Right().RequestValue(150.0)
+	area.Render()
+	c.Check(calls, check.Equals, 2)
+}
+
+func (suite *AreaSuite) TestRenderCallsBoundsChangeHandlerForChangesOfParentEdges(c *check.C) {
+	parent := suite.builder.Build()
+	calls := 0
+	childBuilder := NewAreaBuilder()
+	childBuilder.SetParent(parent)
+	childBuilder.SetLeft(NewOffsetAnchor(parent.Left(), 10.0))
+	childBuilder.SetRight(NewOffsetAnchor(parent.Right(), -10.0))
+	childBuilder.OnBoundsChange(func(*Area) { calls++ })
+	childBuilder.Build()
+
+	parent.Render()
+	parent.Right().RequestValue(200.0)
+	parent.Render()
+
+	c.Check(calls, check.Equals, 2)
+}
+
+func (suite *AreaSuite) TestRemovedAreaStopsObservingItsEdges(c *check.C) {
+	right := NewAbsoluteAnchor(100.0)
+	suite.builder.SetRight(right)
+	suite.builder.OnBoundsChange(func(*Area) {})
+	area := suite.builder.Build()
+
+	area.Render()
+	c.Check(len(right.(*absoluteAnchor).subscriptions), check.Equals, 1)
+	area.Remove()
+	c.Check(len(right.(*absoluteAnchor).subscriptions), check.Equals, 0)
+}
+
+func (suite *AreaSuite) TestCaptureAndBubbleHandlersCanBeAddedToExistingArea(c *check.C) {
+	var record []string
+	eventType := events.EventType("phasedEvent")
+	root := NewAreaBuilder().Build()
+	child := NewAreaBuilder().SetParent(root).OnEvent(eventType, SilentConsumer).Build()
+	child.RequestFocus()
+
+	root.AddCaptureHandler(eventType, suite.aPhaseRecorder(&record, "root"))
+	root.AddBubbleHandler(eventType, suite.aPhaseRecorder(&record, "root"))
+	root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(record, check.DeepEquals, []string{"capture root", "bubble root"})
+}
+
+func (suite *AreaSuite) TestRemovedCaptureAndBubbleHandlersAreNoLongerCalled(c *check.C) {
+	var record []string
+	eventType := events.EventType("phasedEvent")
+	root := NewAreaBuilder().Build()
+	child := NewAreaBuilder().SetParent(root).OnEvent(eventType, SilentConsumer).Build()
+	child.RequestFocus()
+	captureSubscription := root.AddCaptureHandler(eventType, suite.aPhaseRecorder(&record, "first"))
+	root.AddCaptureHandler(eventType, suite.aPhaseRecorder(&record, "second"))
+	bubbleSubscription := root.AddBubbleHandler(eventType, suite.aPhaseRecorder(&record, "first"))
+
+	root.RemoveCaptureHandler(captureSubscription)
+	root.RemoveBubbleHandler(bubbleSubscription)
+	root.HandleEvent(&testingEvent{eventType})
+
+	c.Check(record, check.DeepEquals, []string{"capture second"})
+}
@@ -0,0 +1,31 @@
+package area
+
+type aspectRatioAnchor struct {
+	start      Anchor
+	otherStart Anchor
+	otherEnd   Anchor
+	ratio      float32
+}
+
+// NewAspectRatioAnchor returns an anchor for the end of an extent, which is proportional
+// to the extent of another axis. The value is start + (otherEnd - otherStart) * ratio.
+// For example, the bottom of an area with 16:9 aspect ratio is
+// NewAspectRatioAnchor(top, left, right, 9.0 / 16.0).
+// Requests to set a new value are forwarded to the end of the other axis, keeping the ratio.
+func NewAspectRatioAnchor(start, otherStart, otherEnd Anchor, ratio float32) Anchor {
+	return &aspectRatioAnchor{start: start, otherStart: otherStart, otherEnd: otherEnd, ratio: ratio}
+}
+
+func (anchor *aspectRatioAnchor) Value() float32 {
+	return anchor.start.Value() + (anchor.otherEnd.Value()-anchor.otherStart.Value())*anchor.ratio
+}
+
+func (anchor *aspectRatioAnchor) RequestValue(newValue float32) {
+	if anchor.ratio != 0 {
+		anchor.otherEnd.RequestValue(anchor.otherStart.Value() + (newValue-anchor.start.Value())/anchor.ratio)
+	}
+}
+
+func (anchor *aspectRatioAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.start, anchor.otherStart, anchor.otherEnd}
+}
@@ -0,0 +1,34 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type AspectRatioAnchorSuite struct {
+}
+
+var _ = check.Suite(&AspectRatioAnchorSuite{})
+
+func (suite *AspectRatioAnchorSuite) TestValueIsProportionalToOtherExtent(c *check.C) {
+	anchor := NewAspectRatioAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(0.0), NewAbsoluteAnchor(160.0), 9.0/16.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(100.0))
+}
+
+func (suite *AspectRatioAnchorSuite) TestValueFollowsOtherExtent(c *check.C) {
+	otherEnd := NewAbsoluteAnchor(160.0)
+	anchor := NewAspectRatioAnchor(NewAbsoluteAnchor(0.0), NewAbsoluteAnchor(0.0), otherEnd, 0.5)
+
+	otherEnd.RequestValue(40.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(20.0))
+}
+
+func (suite *AspectRatioAnchorSuite) TestRequestValueUpdatesOtherEnd(c *check.C) {
+	otherEnd := NewAbsoluteAnchor(160.0)
+	anchor := NewAspectRatioAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(0.0), otherEnd, 0.5)
+
+	anchor.RequestValue(60.0)
+
+	c.Check(otherEnd.Value(), check.Equals, float32(100.0))
+}
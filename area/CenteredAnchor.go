@@ -0,0 +1,27 @@
+package area
+
+type centeredAnchor struct {
+	center     Anchor
+	halfExtent float32
+}
+
+// NewCenteredAnchors returns a pair of anchors, which are placed around a center anchor
+// with given extent between them.
+// Requests to set a new value of either anchor move the center, keeping the extent.
+func NewCenteredAnchors(center Anchor, extent float32) (from, to Anchor) {
+	from = &centeredAnchor{center: center, halfExtent: -extent / 2.0}
+	to = &centeredAnchor{center: center, halfExtent: extent / 2.0}
+	return
+}
+
+func (anchor *centeredAnchor) Value() float32 {
+	return anchor.center.Value() + anchor.halfExtent
+}
+
+func (anchor *centeredAnchor) RequestValue(newValue float32) {
+	anchor.center.RequestValue(newValue - anchor.halfExtent)
+}
+
+func (anchor *centeredAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.center}
+}
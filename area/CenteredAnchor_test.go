@@ -0,0 +1,54 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type CenteredAnchorSuite struct {
+}
+
+var _ = check.Suite(&CenteredAnchorSuite{})
+
+func (suite *CenteredAnchorSuite) TestValuesAreAroundCenter_A(c *check.C) {
+	from, to := NewCenteredAnchors(NewAbsoluteAnchor(50.0), 20.0)
+
+	c.Check(from.Value(), check.Equals, float32(40.0))
+	c.Check(to.Value(), check.Equals, float32(60.0))
+}
+
+func (suite *CenteredAnchorSuite) TestValuesAreAroundCenter_B(c *check.C) {
+	from, to := NewCenteredAnchors(NewRelativeAnchor(NewAbsoluteAnchor(0.0), NewAbsoluteAnchor(300.0), 0.5), 100.0)
+
+	c.Check(from.Value(), check.Equals, float32(100.0))
+	c.Check(to.Value(), check.Equals, float32(200.0))
+}
+
+func (suite *CenteredAnchorSuite) TestValuesFollowCenter(c *check.C) {
+	center := NewAbsoluteAnchor(50.0)
+	from, to := NewCenteredAnchors(center, 20.0)
+
+	center.RequestValue(100.0)
+
+	c.Check(from.Value(), check.Equals, float32(90.0))
+	c.Check(to.Value(), check.Equals, float32(110.0))
+}
+
+func (suite *CenteredAnchorSuite) TestRequestValueMovesCenter_A(c *check.C) {
+	center := NewAbsoluteAnchor(50.0)
+	from, to := NewCenteredAnchors(center, 20.0)
+
+	from.RequestValue(0.0)
+
+	c.Check(center.Value(), check.Equals, float32(10.0))
+	c.Check(to.Value(), check.Equals, float32(20.0))
+}
+
+func (suite *CenteredAnchorSuite) TestRequestValueMovesCenter_B(c *check.C) {
+	center := NewAbsoluteAnchor(50.0)
+	from, to := NewCenteredAnchors(center, 20.0)
+
+	to.RequestValue(100.0)
+
+	c.Check(center.Value(), check.Equals, float32(90.0))
+	c.Check(from.Value(), check.Equals, float32(80.0))
+}
@@ -0,0 +1,65 @@
+package area
+
+// changeReporter is implemented by anchors that keep a value of their own, which changes on request.
+// Anchors deriving their value from others only need to be observed through their dependencies.
+type changeReporter interface {
+	// subscribe registers given callback to be called after every change.
+	// The returned function removes the callback again.
+	subscribe(callback func()) (unsubscribe func())
+}
+
+type changeSubscription struct {
+	callback func()
+}
+
+// changeNotifier keeps the callbacks of a change reporter.
+type changeNotifier struct {
+	subscriptions map[*changeSubscription]bool
+}
+
+func (notifier *changeNotifier) subscribe(callback func()) (unsubscribe func()) {
+	subscription := &changeSubscription{callback: callback}
+
+	if notifier.subscriptions == nil {
+		notifier.subscriptions = make(map[*changeSubscription]bool)
+	}
+	notifier.subscriptions[subscription] = true
+
+	return func() { delete(notifier.subscriptions, subscription) }
+}
+
+func (notifier *changeNotifier) notify() {
+	for subscription := range notifier.subscriptions {
+		subscription.callback()
+	}
+}
+
+// observeChanges subscribes given callback to all change reporters within the dependencies
+// of given anchors. Dependencies are determined once, when the observation starts.
+// The returned function ends the observation.
+func observeChanges(anchors []Anchor, callback func()) (stop func()) {
+	visited := make(map[Anchor]bool)
+	var unsubscribers []func()
+	var visit func(Anchor)
+
+	visit = func(anchor Anchor) {
+		if !visited[anchor] {
+			visited[anchor] = true
+			if reporter, isReporter := anchor.(changeReporter); isReporter {
+				unsubscribers = append(unsubscribers, reporter.subscribe(callback))
+			}
+			for _, dependency := range AnchorDependencies(anchor) {
+				visit(dependency)
+			}
+		}
+	}
+	for _, anchor := range anchors {
+		visit(anchor)
+	}
+
+	return func() {
+		for _, unsubscribe := range unsubscribers {
+			unsubscribe()
+		}
+	}
+}
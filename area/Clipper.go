@@ -0,0 +1,40 @@
+package area
+
+// Clipper restricts rendering to a rectangle.
+type Clipper interface {
+	// ClipTo restricts any further rendering to given rectangle.
+	ClipTo(left, top, right, bottom float32)
+	// Unclip removes any restriction.
+	Unclip()
+}
+
+type clipRectangle struct {
+	left, top, right, bottom float32
+}
+
+func (rect clipRectangle) intersect(other *clipRectangle) clipRectangle {
+	result := rect
+
+	if other != nil {
+		if other.left > result.left {
+			result.left = other.left
+		}
+		if other.top > result.top {
+			result.top = other.top
+		}
+		if other.right < result.right {
+			result.right = other.right
+		}
+		if other.bottom < result.bottom {
+			result.bottom = other.bottom
+		}
+	}
+	if result.right < result.left {
+		result.right = result.left
+	}
+	if result.bottom < result.top {
+		result.bottom = result.top
+	}
+
+	return result
+}
@@ -0,0 +1,199 @@
+package area
+
+import (
+	"time"
+
+	"github.com/dertseha/jellui/area/events"
+)
+
+type buttonPress struct {
+	area *Area
+	x, y float32
+}
+
+type buttonClick struct {
+	buttonPress
+	time time.Time
+}
+
+// Dispatcher forwards events into an area tree. Based on the sequence of
+// forwarded events, it synthesizes further events, such as clicks.
+type Dispatcher struct {
+	root         *Area
+	focusManager *FocusManager
+
+	clickTolerance  float32
+	doubleClickTime time.Duration
+	now             func() time.Time
+
+	pressed    map[uint32]buttonPress
+	lastClicks map[uint32]buttonClick
+
+	hoveredPath []*Area
+}
+
+// NewDispatcher returns a new dispatcher for the given root area.
+func NewDispatcher(root *Area) *Dispatcher {
+	dispatcher := &Dispatcher{
+		root:         root,
+		focusManager: NewFocusManager(root),
+
+		clickTolerance:  4.0,
+		doubleClickTime: 500 * time.Millisecond,
+		now:             time.Now,
+
+		pressed:    make(map[uint32]buttonPress),
+		lastClicks: make(map[uint32]buttonClick)}
+
+	return dispatcher
+}
+
+// SetClickTolerance sets the distance the cursor may move between press and
+// release of a button to still be considered a click. Default: 4.0
+func (dispatcher *Dispatcher) SetClickTolerance(value float32) {
+	dispatcher.clickTolerance = value
+}
+
+// SetDoubleClickTime sets the maximum time between two clicks to be considered
+// a double-click. Default: 500ms
+func (dispatcher *Dispatcher) SetDoubleClickTime(value time.Duration) {
+	dispatcher.doubleClickTime = value
+}
+
+// FocusManager returns the manager for keyboard navigation of the focus.
+func (dispatcher *Dispatcher) FocusManager() *FocusManager {
+	return dispatcher.focusManager
+}
+
+// HoveredTooltip returns the innermost area below the cursor that has a tooltip, together with its text.
+// Returns nil if none of the hovered areas has a tooltip.
+func (dispatcher *Dispatcher) HoveredTooltip() (owner *Area, text string) {
+	dispatcher.pruneHoveredPath()
+	for index := len(dispatcher.hoveredPath) - 1; (owner == nil) && (index >= 0); index-- {
+		if candidate := dispatcher.hoveredPath[index]; candidate.Tooltip() != "" {
+			owner, text = candidate, candidate.Tooltip()
+		}
+	}
+	return
+}
+
+// HandleEvent forwards the given event to the root area.
+// Events not consumed by the areas are passed to the focus manager, for navigation.
+// It returns true if the event was consumed.
+func (dispatcher *Dispatcher) HandleEvent(event events.Event) (consumed bool) {
+	consumed = dispatcher.root.HandleEvent(event)
+	if !consumed {
+		consumed = dispatcher.focusManager.HandleEvent(event)
+	}
+	return
+}
+
+// DispatchPositionalEvent forwards the given event to the root area.
+// Moving the cursor notifies the areas the cursor left or entered with
+// according hover events, before the move event is forwarded.
+// Releasing a button on the same area it was pressed, without moving the cursor
+// beyond the click tolerance, additionally dispatches a click event. A second click
+// within the double-click time is followed by a double-click event.
+// The areas of press and release are those at the position of the event before it is forwarded,
+// so that handlers changing the area tree do not affect the click.
+// It returns true if the event was consumed.
+func (dispatcher *Dispatcher) DispatchPositionalEvent(event events.PositionalEvent) (consumed bool) {
+	x, y := event.Position()
+	hitArea := dispatcher.root.areaAt(x, y)
+
+	if moveEvent, isMoveEvent := event.(*events.MouseMoveEvent); isMoveEvent {
+		dispatcher.updateHover(moveEvent)
+	}
+
+	consumed = dispatcher.root.DispatchPositionalEvent(event)
+
+	if buttonEvent, isButtonEvent := event.(*events.MouseButtonEvent); isButtonEvent {
+		for button := uint32(1); (button != 0) && (button <= buttonEvent.AffectedButtons()); button <<= 1 {
+			if (buttonEvent.AffectedButtons() & button) != 0 {
+				if buttonEvent.EventType() == events.MouseButtonDownEventType {
+					dispatcher.onButtonDown(buttonEvent, button, hitArea)
+				} else if buttonEvent.EventType() == events.MouseButtonUpEventType {
+					dispatcher.onButtonUp(buttonEvent, button, hitArea)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// updateHover dispatches leave and enter events to the areas the cursor left or entered.
+// Like any other event, each hover event passes the capture and bubble handlers along the path
+// from the root to the affected area.
+func (dispatcher *Dispatcher) updateHover(event *events.MouseMoveEvent) {
+	x, y := event.Position()
+	dispatcher.pruneHoveredPath()
+	oldPath := dispatcher.hoveredPath
+	newPath := dispatcher.root.areaAt(x, y).path()
+	common := 0
+
+	for (common < len(oldPath)) && (common < len(newPath)) && (oldPath[common] == newPath[common]) {
+		common++
+	}
+	dispatcher.hoveredPath = newPath
+	for index := len(oldPath) - 1; index >= common; index-- {
+		dispatcher.dispatchHover(oldPath[index], events.NewMouseHoverEvent(events.MouseLeaveEventType,
+			x, y, event.Modifier(), event.Buttons()))
+	}
+	for index := common; index < len(newPath); index++ {
+		dispatcher.dispatchHover(newPath[index], events.NewMouseHoverEvent(events.MouseEnterEventType,
+			x, y, event.Modifier(), event.Buttons()))
+	}
+}
+
+func (dispatcher *Dispatcher) dispatchHover(target *Area, event events.Event) {
+	dispatcher.root.propagate(event, target, func() bool { return target.tryEventHandlerFor(event) })
+}
+
+// pruneHoveredPath cuts the hovered path at the first area that was hidden, or removed from
+// the tree, since the path was determined. These areas receive no leave event.
+func (dispatcher *Dispatcher) pruneHoveredPath() {
+	path := dispatcher.hoveredPath
+	valid := 0
+
+	for (valid < len(path)) && path[valid].IsVisible() &&
+		(((valid == 0) && (path[valid] == dispatcher.root)) || ((valid > 0) && (path[valid].parent == path[valid-1]))) {
+		valid++
+	}
+	dispatcher.hoveredPath = path[:valid]
+}
+
+func (dispatcher *Dispatcher) onButtonDown(event *events.MouseButtonEvent, button uint32, pressedArea *Area) {
+	x, y := event.Position()
+	dispatcher.pressed[button] = buttonPress{area: pressedArea, x: x, y: y}
+}
+
+func (dispatcher *Dispatcher) onButtonUp(event *events.MouseButtonEvent, button uint32, releasedArea *Area) {
+	press, wasPressed := dispatcher.pressed[button]
+	x, y := event.Position()
+	released := buttonPress{area: releasedArea, x: x, y: y}
+
+	delete(dispatcher.pressed, button)
+	if wasPressed && (released.area != nil) && (released.area == press.area) && dispatcher.isWithinTolerance(press, released) {
+		now := dispatcher.now()
+		lastClick, hadClick := dispatcher.lastClicks[button]
+
+		dispatcher.root.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonClickedEventType,
+			x, y, event.Modifier(), event.Buttons(), button))
+		if hadClick && (lastClick.area == released.area) && dispatcher.isWithinTolerance(lastClick.buttonPress, released) &&
+			(now.Sub(lastClick.time) <= dispatcher.doubleClickTime) {
+			delete(dispatcher.lastClicks, button)
+			dispatcher.root.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDoubleClickedEventType,
+				x, y, event.Modifier(), event.Buttons(), button))
+		} else {
+			dispatcher.lastClicks[button] = buttonClick{buttonPress: released, time: now}
+		}
+	}
+}
+
+func (dispatcher *Dispatcher) isWithinTolerance(a, b buttonPress) bool {
+	dx := a.x - b.x
+	dy := a.y - b.y
+
+	return (dx*dx + dy*dy) <= (dispatcher.clickTolerance * dispatcher.clickTolerance)
+}
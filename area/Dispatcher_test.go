@@ -0,0 +1,304 @@
+package area
+
+import (
+	"time"
+
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/input"
+
+	check "gopkg.in/check.v1"
+)
+
+type DispatcherSuite struct {
+	root       *Area
+	dispatcher *Dispatcher
+	now        time.Time
+
+	handled []events.EventType
+}
+
+var _ = check.Suite(&DispatcherSuite{})
+
+func (suite *DispatcherSuite) SetUpTest(c *check.C) {
+	suite.handled = nil
+	suite.now = time.Unix(1000, 0)
+
+	rootBuilder := NewAreaBuilder()
+	rootBuilder.SetRight(NewAbsoluteAnchor(100.0))
+	rootBuilder.SetBottom(NewAbsoluteAnchor(100.0))
+	suite.root = rootBuilder.Build()
+
+	suite.dispatcher = NewDispatcher(suite.root)
+	suite.dispatcher.now = func() time.Time { return suite.now }
+}
+
+func (suite *DispatcherSuite) aChildArea(left, right float32) *Area {
+	builder := NewAreaBuilder()
+	builder.SetParent(suite.root)
+	builder.SetLeft(NewAbsoluteAnchor(left))
+	builder.SetRight(NewAbsoluteAnchor(right))
+	builder.SetBottom(suite.root.Bottom())
+	for _, eventType := range []events.EventType{events.MouseButtonClickedEventType, events.MouseButtonDoubleClickedEventType} {
+		builder.OnEvent(eventType, func(area *Area, event events.Event) bool {
+			suite.handled = append(suite.handled, event.EventType())
+			return true
+		})
+	}
+	return builder.Build()
+}
+
+func (suite *DispatcherSuite) press(x, y float32) {
+	suite.dispatcher.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
+		x, y, 0, input.MousePrimary, input.MousePrimary))
+}
+
+func (suite *DispatcherSuite) release(x, y float32) {
+	suite.dispatcher.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonUpEventType,
+		x, y, 0, 0, input.MousePrimary))
+}
+
+func (suite *DispatcherSuite) TestReleaseOnSameAreaDispatchesClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+
+	suite.press(10.0, 10.0)
+	suite.release(10.0, 10.0)
+
+	c.Check(suite.handled, check.DeepEquals, []events.EventType{events.MouseButtonClickedEventType})
+}
+
+func (suite *DispatcherSuite) TestReleaseWithinToleranceDispatchesClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+
+	suite.press(10.0, 10.0)
+	suite.release(12.0, 13.0)
+
+	c.Check(suite.handled, check.DeepEquals, []events.EventType{events.MouseButtonClickedEventType})
+}
+
+func (suite *DispatcherSuite) TestReleaseBeyondToleranceDispatchesNoClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+
+	suite.press(10.0, 10.0)
+	suite.release(20.0, 10.0)
+
+	c.Check(suite.handled, check.IsNil)
+}
+
+func (suite *DispatcherSuite) TestReleaseOnOtherAreaDispatchesNoClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+	suite.aChildArea(50.0, 100.0)
+	suite.dispatcher.SetClickTolerance(10.0)
+
+	suite.press(48.0, 10.0)
+	suite.release(52.0, 10.0)
+
+	c.Check(suite.handled, check.IsNil)
+}
+
+func (suite *DispatcherSuite) TestReleaseWithoutPressDispatchesNoClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+
+	suite.release(10.0, 10.0)
+
+	c.Check(suite.handled, check.IsNil)
+}
+
+func (suite *DispatcherSuite) TestPressIsRecordedForAreaBeforeDispatch(c *check.C) {
+	pressed := suite.aChildArea(0.0, 50.0)
+	pressed.AddEventHandler(events.MouseButtonDownEventType, func(*Area, events.Event) bool {
+		suite.aChildArea(0.0, 50.0)
+		return true
+	})
+
+	suite.press(10.0, 10.0)
+	suite.release(10.0, 10.0)
+
+	c.Check(suite.handled, check.IsNil)
+}
+
+func (suite *DispatcherSuite) TestSecondClickWithinTimeDispatchesDoubleClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+
+	suite.press(10.0, 10.0)
+	suite.release(10.0, 10.0)
+	suite.now = suite.now.Add(200 * time.Millisecond)
+	suite.press(10.0, 10.0)
+	suite.release(10.0, 10.0)
+
+	c.Check(suite.handled, check.DeepEquals, []events.EventType{
+		events.MouseButtonClickedEventType,
+		events.MouseButtonClickedEventType,
+		events.MouseButtonDoubleClickedEventType})
+}
+
+func (suite *DispatcherSuite) TestSecondClickBeyondTimeDispatchesNoDoubleClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+	suite.dispatcher.SetDoubleClickTime(100 * time.Millisecond)
+
+	suite.press(10.0, 10.0)
+	suite.release(10.0, 10.0)
+	suite.now = suite.now.Add(200 * time.Millisecond)
+	suite.press(10.0, 10.0)
+	suite.release(10.0, 10.0)
+
+	c.Check(suite.handled, check.DeepEquals, []events.EventType{
+		events.MouseButtonClickedEventType,
+		events.MouseButtonClickedEventType})
+}
+
+func (suite *DispatcherSuite) TestThirdClickDispatchesNoFurtherDoubleClick(c *check.C) {
+	suite.aChildArea(0.0, 50.0)
+
+	for i := 0; i < 3; i++ {
+		suite.press(10.0, 10.0)
+		suite.release(10.0, 10.0)
+	}
+
+	c.Check(suite.handled, check.DeepEquals, []events.EventType{
+		events.MouseButtonClickedEventType,
+		events.MouseButtonClickedEventType,
+		events.MouseButtonDoubleClickedEventType,
+		events.MouseButtonClickedEventType})
+}
+
+func (suite *DispatcherSuite) aHoverRecordingArea(parent *Area, id string, left, right float32, record *[]string) *Area {
+	builder := NewAreaBuilder()
+	builder.SetParent(parent)
+	builder.SetLeft(NewAbsoluteAnchor(left))
+	builder.SetRight(NewAbsoluteAnchor(right))
+	builder.SetBottom(suite.root.Bottom())
+	builder.OnEvent(events.MouseEnterEventType, func(*Area, events.Event) bool {
+		*record = append(*record, "enter "+id)
+		return true
+	})
+	builder.OnEvent(events.MouseLeaveEventType, func(*Area, events.Event) bool {
+		*record = append(*record, "leave "+id)
+		return true
+	})
+	return builder.Build()
+}
+
+func (suite *DispatcherSuite) move(x, y float32) {
+	suite.dispatcher.DispatchPositionalEvent(events.NewMouseMoveEvent(x, y, 0, 0))
+}
+
+func (suite *DispatcherSuite) TestMoveIntoAreaDispatchesEnterFromOutermost(c *check.C) {
+	var record []string
+	outer := suite.aHoverRecordingArea(suite.root, "outer", 0.0, 50.0, &record)
+	suite.aHoverRecordingArea(outer, "inner", 10.0, 20.0, &record)
+
+	suite.move(15.0, 10.0)
+
+	c.Check(record, check.DeepEquals, []string{"enter outer", "enter inner"})
+}
+
+func (suite *DispatcherSuite) TestMoveOutOfAreaDispatchesLeaveFromInnermost(c *check.C) {
+	var record []string
+	outer := suite.aHoverRecordingArea(suite.root, "outer", 0.0, 50.0, &record)
+	suite.aHoverRecordingArea(outer, "inner", 10.0, 20.0, &record)
+
+	suite.move(15.0, 10.0)
+	record = nil
+	suite.move(75.0, 10.0)
+
+	c.Check(record, check.DeepEquals, []string{"leave inner", "leave outer"})
+}
+
+func (suite *DispatcherSuite) TestMoveWithinAreaDispatchesNoHoverEvents(c *check.C) {
+	var record []string
+	suite.aHoverRecordingArea(suite.root, "area", 0.0, 50.0, &record)
+
+	suite.move(15.0, 10.0)
+	record = nil
+	suite.move(25.0, 10.0)
+
+	c.Check(record, check.IsNil)
+}
+
+func (suite *DispatcherSuite) TestMoveBetweenSiblingsDispatchesLeaveBeforeEnter(c *check.C) {
+	var record []string
+	outer := suite.aHoverRecordingArea(suite.root, "outer", 0.0, 50.0, &record)
+	suite.aHoverRecordingArea(outer, "left", 0.0, 20.0, &record)
+	suite.aHoverRecordingArea(outer, "right", 20.0, 50.0, &record)
+
+	suite.move(15.0, 10.0)
+	record = nil
+	suite.move(25.0, 10.0)
+
+	c.Check(record, check.DeepEquals, []string{"leave left", "enter right"})
+}
+
+func (suite *DispatcherSuite) TestHoverEventsPassCaptureAndBubbleHandlers(c *check.C) {
+	var record []string
+	suite.root.AddCaptureHandler(events.MouseEnterEventType,
+		func(context *EventContext, event events.Event) { record = append(record, "capture root") })
+	suite.root.AddBubbleHandler(events.MouseEnterEventType,
+		func(context *EventContext, event events.Event) { record = append(record, "bubble root") })
+	suite.aHoverRecordingArea(suite.root, "area", 0.0, 50.0, &record)
+
+	suite.move(15.0, 10.0)
+
+	c.Check(record, check.DeepEquals, []string{
+		"capture root", "bubble root",
+		"capture root", "enter area", "bubble root"})
+}
+
+func (suite *DispatcherSuite) TestRemovedAreaReceivesNoLeaveEvent(c *check.C) {
+	var record []string
+	outer := suite.aHoverRecordingArea(suite.root, "outer", 0.0, 50.0, &record)
+	inner := suite.aHoverRecordingArea(outer, "inner", 10.0, 20.0, &record)
+
+	suite.move(15.0, 10.0)
+	record = nil
+	inner.Remove()
+	suite.move(75.0, 10.0)
+
+	c.Check(record, check.DeepEquals, []string{"leave outer"})
+}
+
+func (suite *DispatcherSuite) TestHiddenAreaIsNoLongerHovered(c *check.C) {
+	var record []string
+	hidden := suite.aHoverRecordingArea(suite.root, "area", 0.0, 50.0, &record)
+	hidden.SetTooltip("tip")
+
+	suite.move(15.0, 10.0)
+	hidden.SetVisible(false)
+	owner, _ := suite.dispatcher.HoveredTooltip()
+
+	c.Check(owner, check.IsNil)
+}
+
+func (suite *DispatcherSuite) TestUnconsumedTabKeyMovesFocus(c *check.C) {
+	builder := NewAreaBuilder()
+	builder.SetParent(suite.root)
+	builder.SetFocusable(true)
+	focusable := builder.Build()
+
+	consumed := suite.dispatcher.HandleEvent(events.NewKeyEvent(input.KeyTab, input.ModNone))
+
+	c.Check(consumed, check.Equals, true)
+	c.Check(focusable.HasFocus(), check.Equals, true)
+}
+
+func (suite *DispatcherSuite) TestHoveredTooltipReturnsInnermostAreaWithTooltip(c *check.C) {
+	var record []string
+	outer := suite.aHoverRecordingArea(suite.root, "outer", 0.0, 50.0, &record)
+	suite.aHoverRecordingArea(outer, "inner", 10.0, 20.0, &record)
+	outer.SetTooltip("outer tip")
+
+	suite.move(15.0, 10.0)
+	owner, text := suite.dispatcher.HoveredTooltip()
+
+	c.Check(owner, check.Equals, outer)
+	c.Check(text, check.Equals, "outer tip")
+}
+
+func (suite *DispatcherSuite) TestHoveredTooltipReturnsNilWithoutTooltip(c *check.C) {
+	var record []string
+	suite.aHoverRecordingArea(suite.root, "area", 0.0, 50.0, &record)
+
+	suite.move(15.0, 10.0)
+	owner, _ := suite.dispatcher.HoveredTooltip()
+
+	c.Check(owner, check.IsNil)
+}
@@ -0,0 +1,55 @@
+package area
+
+import (
+	"github.com/dertseha/jellui/area/events"
+)
+
+// EventPhase identifies the stage an event is in while it is dispatched through the area tree.
+type EventPhase int
+
+const (
+	// CapturePhase is the stage in which the event travels from the root towards the target.
+	CapturePhase = EventPhase(0)
+	// TargetPhase is the stage in which the event has arrived at the target.
+	TargetPhase = EventPhase(1)
+	// BubblePhase is the stage in which the event travels from the target back to the root.
+	BubblePhase = EventPhase(2)
+)
+
+// PhasedEventHandler is called for events passing an area during capture or bubble phase.
+type PhasedEventHandler func(*EventContext, events.Event)
+
+// EventContext describes the state of an event being dispatched through the area tree.
+type EventContext struct {
+	target  *Area
+	current *Area
+	phase   EventPhase
+
+	propagationStopped bool
+}
+
+// Target returns the area the event is dispatched to.
+func (context *EventContext) Target() *Area {
+	return context.target
+}
+
+// Current returns the area whose handler is currently called.
+func (context *EventContext) Current() *Area {
+	return context.current
+}
+
+// Phase returns the current stage of the dispatch.
+func (context *EventContext) Phase() EventPhase {
+	return context.phase
+}
+
+// StopPropagation prevents the event from being passed to any further handler or area.
+// A stopped event is regarded as consumed.
+func (context *EventContext) StopPropagation() {
+	context.propagationStopped = true
+}
+
+// IsPropagationStopped returns true if StopPropagation() was called.
+func (context *EventContext) IsPropagationStopped() bool {
+	return context.propagationStopped
+}
@@ -0,0 +1,24 @@
+package area
+
+type extentAnchor struct {
+	from Anchor
+	to   Anchor
+}
+
+// NewExtentAnchor returns a virtual anchor for the distance between two other anchors.
+// Requests to set a new value move the to anchor.
+func NewExtentAnchor(from, to Anchor) Anchor {
+	return &extentAnchor{from: from, to: to}
+}
+
+func (anchor *extentAnchor) Value() float32 {
+	return anchor.to.Value() - anchor.from.Value()
+}
+
+func (anchor *extentAnchor) RequestValue(newValue float32) {
+	anchor.to.RequestValue(anchor.from.Value() + newValue)
+}
+
+func (anchor *extentAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.from, anchor.to}
+}
@@ -0,0 +1,51 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type ExtentAnchorSuite struct {
+}
+
+var _ = check.Suite(&ExtentAnchorSuite{})
+
+func (suite *ExtentAnchorSuite) TestValueReturnsDistance_A(c *check.C) {
+	anchor := NewExtentAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(30.0))
+
+	c.Check(anchor.Value(), check.Equals, float32(20.0))
+}
+
+func (suite *ExtentAnchorSuite) TestValueReturnsDistance_B(c *check.C) {
+	anchor := NewExtentAnchor(NewAbsoluteAnchor(-5.0), NewAbsoluteAnchor(5.0))
+
+	c.Check(anchor.Value(), check.Equals, float32(10.0))
+}
+
+func (suite *ExtentAnchorSuite) TestRequestValueMovesToAnchor(c *check.C) {
+	from := NewAbsoluteAnchor(10.0)
+	to := NewAbsoluteAnchor(30.0)
+	anchor := NewExtentAnchor(from, to)
+
+	anchor.RequestValue(50.0)
+
+	c.Check(from.Value(), check.Equals, float32(10.0))
+	c.Check(to.Value(), check.Equals, float32(60.0))
+}
+
+func (suite *ExtentAnchorSuite) TestAreaWidthAndHeight(c *check.C) {
+	builder := NewAreaBuilder()
+	builder.SetLeft(NewAbsoluteAnchor(10.0))
+	builder.SetTop(NewAbsoluteAnchor(20.0))
+	builder.SetRight(NewAbsoluteAnchor(110.0))
+	builder.SetBottom(NewAbsoluteAnchor(70.0))
+	area := builder.Build()
+
+	c.Check(area.Width().Value(), check.Equals, float32(100.0))
+	c.Check(area.Height().Value(), check.Equals, float32(50.0))
+
+	area.Width().RequestValue(40.0)
+	area.Height().RequestValue(30.0)
+
+	c.Check(area.Right().Value(), check.Equals, float32(50.0))
+	c.Check(area.Bottom().Value(), check.Equals, float32(50.0))
+}
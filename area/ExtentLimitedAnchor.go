@@ -0,0 +1,42 @@
+package area
+
+type extentLimitedAnchor struct {
+	opposite  Anchor
+	reference Anchor
+	minExtent float32
+	maxExtent float32
+}
+
+// NewExtentLimitedAnchor returns an anchor for a right or bottom edge, which limits the
+// distance of a reference anchor to the opposite edge between a minimum and maximum extent.
+// A maximum extent of zero or less means no maximum.
+// Requests to set a new value are forwarded to the reference anchor, limited to the allowed extent.
+func NewExtentLimitedAnchor(opposite, reference Anchor, minExtent, maxExtent float32) Anchor {
+	return &extentLimitedAnchor{opposite: opposite, reference: reference, minExtent: minExtent, maxExtent: maxExtent}
+}
+
+func (anchor *extentLimitedAnchor) limited(value float32) float32 {
+	oppositeValue := anchor.opposite.Value()
+	extent := value - oppositeValue
+
+	if (anchor.maxExtent > 0) && (extent > anchor.maxExtent) {
+		extent = anchor.maxExtent
+	}
+	if extent < anchor.minExtent {
+		extent = anchor.minExtent
+	}
+
+	return oppositeValue + extent
+}
+
+func (anchor *extentLimitedAnchor) Value() float32 {
+	return anchor.limited(anchor.reference.Value())
+}
+
+func (anchor *extentLimitedAnchor) RequestValue(newValue float32) {
+	anchor.reference.RequestValue(anchor.limited(newValue))
+}
+
+func (anchor *extentLimitedAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.opposite, anchor.reference}
+}
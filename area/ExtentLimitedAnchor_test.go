@@ -0,0 +1,43 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type ExtentLimitedAnchorSuite struct {
+}
+
+var _ = check.Suite(&ExtentLimitedAnchorSuite{})
+
+func (suite *ExtentLimitedAnchorSuite) TestValueReturnsReferenceWithinLimits(c *check.C) {
+	anchor := NewExtentLimitedAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(50.0), 20.0, 100.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(50.0))
+}
+
+func (suite *ExtentLimitedAnchorSuite) TestValueKeepsMinimumExtent(c *check.C) {
+	anchor := NewExtentLimitedAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(15.0), 20.0, 100.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(30.0))
+}
+
+func (suite *ExtentLimitedAnchorSuite) TestValueKeepsMaximumExtent(c *check.C) {
+	anchor := NewExtentLimitedAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(200.0), 20.0, 100.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(110.0))
+}
+
+func (suite *ExtentLimitedAnchorSuite) TestMaximumOfZeroMeansUnlimited(c *check.C) {
+	anchor := NewExtentLimitedAnchor(NewAbsoluteAnchor(10.0), NewAbsoluteAnchor(500.0), 20.0, 0.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(500.0))
+}
+
+func (suite *ExtentLimitedAnchorSuite) TestRequestValueForwardsLimitedValue(c *check.C) {
+	reference := NewAbsoluteAnchor(50.0)
+	anchor := NewExtentLimitedAnchor(NewAbsoluteAnchor(10.0), reference, 20.0, 100.0)
+
+	anchor.RequestValue(300.0)
+
+	c.Check(reference.Value(), check.Equals, float32(110.0))
+}
@@ -0,0 +1,115 @@
+package area
+
+import (
+	"sort"
+
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/input"
+)
+
+// FocusManager moves the focus between the focusable areas of an area tree.
+type FocusManager struct {
+	root *Area
+}
+
+// NewFocusManager returns a new focus manager for the given root area.
+func NewFocusManager(root *Area) *FocusManager {
+	return &FocusManager{root: root}
+}
+
+// Focused returns the innermost focusable area that currently has the focus.
+// Returns nil if no focusable area has the focus.
+func (manager *FocusManager) Focused() (focused *Area) {
+	for current := manager.root; current != nil; current = current.focusedArea {
+		if current.IsFocusable() {
+			focused = current
+		}
+	}
+	return
+}
+
+// FocusNext moves the focus to the next focusable area.
+// If a modal area is visible, only the areas within the topmost modal area are considered.
+func (manager *FocusManager) FocusNext() {
+	manager.moveFocus(1)
+}
+
+// FocusPrevious moves the focus to the previous focusable area.
+func (manager *FocusManager) FocusPrevious() {
+	manager.moveFocus(-1)
+}
+
+// HandleEvent moves the focus for key events of the tab key. Shift reverses the direction.
+// It returns true if the event was consumed.
+func (manager *FocusManager) HandleEvent(event events.Event) (consumed bool) {
+	if keyEvent, isKeyEvent := event.(*events.KeyEvent); isKeyEvent && (keyEvent.Key() == input.KeyTab) {
+		if keyEvent.Modifier().Has(input.ModShift) {
+			manager.FocusPrevious()
+		} else {
+			manager.FocusNext()
+		}
+		consumed = true
+	}
+	return
+}
+
+func (manager *FocusManager) moveFocus(direction int) {
+	candidates := manager.focusableAreas()
+	count := len(candidates)
+
+	if count > 0 {
+		focused := manager.Focused()
+		nextIndex := 0
+
+		if direction < 0 {
+			nextIndex = count - 1
+		}
+		for index, candidate := range candidates {
+			if candidate == focused {
+				nextIndex = (index + direction + count) % count
+			}
+		}
+		candidates[nextIndex].RequestFocus()
+	}
+}
+
+func (manager *FocusManager) focusableAreas() []*Area {
+	var result []*Area
+	var collect func(*Area)
+
+	collect = func(area *Area) {
+		if area.IsVisible() {
+			if area.IsFocusable() {
+				result = append(result, area)
+			}
+			for _, child := range area.children {
+				collect(child)
+			}
+		}
+	}
+	collect(manager.scope())
+	sort.SliceStable(result, func(a, b int) bool { return result[a].TabIndex() < result[b].TabIndex() })
+
+	return result
+}
+
+// scope returns the topmost visible modal area, or the root if there is none.
+// Areas are regarded in order of rendering; the last visited modal area is the topmost one.
+func (manager *FocusManager) scope() *Area {
+	scope := manager.root
+	var find func(*Area)
+
+	find = func(area *Area) {
+		if area.IsVisible() {
+			if area.IsModal() {
+				scope = area
+			}
+			for _, child := range area.children {
+				find(child)
+			}
+		}
+	}
+	find(manager.root)
+
+	return scope
+}
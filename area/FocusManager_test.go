@@ -0,0 +1,155 @@
+package area
+
+import (
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/input"
+
+	check "gopkg.in/check.v1"
+)
+
+type FocusManagerSuite struct {
+	root    *Area
+	manager *FocusManager
+}
+
+var _ = check.Suite(&FocusManagerSuite{})
+
+func (suite *FocusManagerSuite) SetUpTest(c *check.C) {
+	suite.root = NewAreaBuilder().Build()
+	suite.manager = NewFocusManager(suite.root)
+}
+
+func (suite *FocusManagerSuite) aFocusableArea(parent *Area, tabIndex int) *Area {
+	builder := NewAreaBuilder()
+	builder.SetParent(parent)
+	builder.SetFocusable(true)
+	builder.SetTabIndex(tabIndex)
+	return builder.Build()
+}
+
+func (suite *FocusManagerSuite) TestFocusedReturnsNilWithoutFocus(c *check.C) {
+	suite.aFocusableArea(suite.root, 0)
+
+	c.Check(suite.manager.Focused(), check.IsNil)
+}
+
+func (suite *FocusManagerSuite) TestFocusedReturnsInnermostFocusableArea(c *check.C) {
+	outer := suite.aFocusableArea(suite.root, 0)
+	middle := NewAreaBuilder().SetParent(outer).Build()
+	inner := suite.aFocusableArea(middle, 0)
+	plain := NewAreaBuilder().SetParent(inner).Build()
+
+	plain.RequestFocus()
+
+	c.Check(suite.manager.Focused(), check.Equals, inner)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextStartsWithFirstArea(c *check.C) {
+	first := suite.aFocusableArea(suite.root, 0)
+	suite.aFocusableArea(suite.root, 0)
+
+	suite.manager.FocusNext()
+
+	c.Check(first.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextFollowsTreeOrder(c *check.C) {
+	first := suite.aFocusableArea(suite.root, 0)
+	inner := suite.aFocusableArea(first, 0)
+	last := suite.aFocusableArea(suite.root, 0)
+
+	suite.manager.FocusNext()
+	suite.manager.FocusNext()
+	c.Check(inner.HasFocus(), check.Equals, true)
+	suite.manager.FocusNext()
+	c.Check(last.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextPrefersLowerTabIndex(c *check.C) {
+	late := suite.aFocusableArea(suite.root, 2)
+	early := suite.aFocusableArea(suite.root, 1)
+
+	suite.manager.FocusNext()
+	c.Check(early.HasFocus(), check.Equals, true)
+	suite.manager.FocusNext()
+	c.Check(late.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextWrapsAround(c *check.C) {
+	first := suite.aFocusableArea(suite.root, 0)
+	second := suite.aFocusableArea(suite.root, 0)
+
+	second.RequestFocus()
+	suite.manager.FocusNext()
+
+	c.Check(first.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestFocusPreviousStartsWithLastArea(c *check.C) {
+	suite.aFocusableArea(suite.root, 0)
+	last := suite.aFocusableArea(suite.root, 0)
+
+	suite.manager.FocusPrevious()
+
+	c.Check(last.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextSkipsInvisibleAreas(c *check.C) {
+	hidden := suite.aFocusableArea(suite.root, 0)
+	suite.aFocusableArea(hidden, 0)
+	visible := suite.aFocusableArea(suite.root, 0)
+	hidden.SetVisible(false)
+
+	suite.manager.FocusNext()
+
+	c.Check(visible.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestHandleEventMovesFocusOnTab(c *check.C) {
+	first := suite.aFocusableArea(suite.root, 0)
+	second := suite.aFocusableArea(suite.root, 0)
+
+	consumed := suite.manager.HandleEvent(events.NewKeyEvent(input.KeyTab, input.ModNone))
+	c.Check(consumed, check.Equals, true)
+	c.Check(first.HasFocus(), check.Equals, true)
+
+	suite.manager.HandleEvent(events.NewKeyEvent(input.KeyTab, input.ModNone))
+	c.Check(second.HasFocus(), check.Equals, true)
+
+	suite.manager.HandleEvent(events.NewKeyEvent(input.KeyTab, input.ModShift))
+	c.Check(first.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestHandleEventIgnoresOtherKeys(c *check.C) {
+	suite.aFocusableArea(suite.root, 0)
+
+	consumed := suite.manager.HandleEvent(events.NewKeyEvent(input.KeyEnter, input.ModNone))
+
+	c.Check(consumed, check.Equals, false)
+	c.Check(suite.manager.Focused(), check.IsNil)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextStaysWithinModalArea(c *check.C) {
+	suite.aFocusableArea(suite.root, 0)
+	modal := NewAreaBuilder().SetParent(suite.root).SetModal(true).Build()
+	first := suite.aFocusableArea(modal, 0)
+	second := suite.aFocusableArea(modal, 0)
+
+	suite.manager.FocusNext()
+	c.Check(first.HasFocus(), check.Equals, true)
+	suite.manager.FocusNext()
+	c.Check(second.HasFocus(), check.Equals, true)
+	suite.manager.FocusNext()
+	c.Check(first.HasFocus(), check.Equals, true)
+}
+
+func (suite *FocusManagerSuite) TestFocusNextIgnoresInvisibleModalArea(c *check.C) {
+	outside := suite.aFocusableArea(suite.root, 0)
+	modal := NewAreaBuilder().SetParent(suite.root).SetModal(true).Build()
+	suite.aFocusableArea(modal, 1)
+	modal.SetVisible(false)
+
+	suite.manager.FocusNext()
+
+	c.Check(outside.HasFocus(), check.Equals, true)
+}
@@ -46,3 +46,7 @@ func (anchor *limitedAnchor) RequestValue(newValue float32) {
 		anchor.reference.RequestValue(forwardedValue)
 	}
 }
+
+func (anchor *limitedAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.from, anchor.to, anchor.reference}
+}
@@ -0,0 +1,119 @@
+package area
+
+// AnchorChangeListener is called when the value of an observable anchor changed.
+type AnchorChangeListener func(anchor *ObservableAnchor, newValue float32)
+
+// AnchorChangeSubscription identifies a listener registered with an observable anchor.
+type AnchorChangeSubscription struct {
+	listener AnchorChangeListener
+}
+
+// ObservableAnchor caches the value of a base anchor and notifies listeners when
+// the value changes.
+// While listeners are registered, changes requested anywhere in the chain of the base are
+// detected immediately, as long as they are requested on anchors of this package.
+// Changes of other anchors are detected only after Invalidate() was called.
+// The chain of the base is determined when the first listener is registered.
+type ObservableAnchor struct {
+	base      Anchor
+	value     float32
+	dirty     bool
+	listeners []*AnchorChangeSubscription
+
+	stopObservingBase func()
+}
+
+// NewObservableAnchor returns an anchor that observes given base.
+// Requests to set a new value are forwarded to the base.
+// The base only refers to the observable anchor while listeners are registered.
+func NewObservableAnchor(base Anchor) *ObservableAnchor {
+	return &ObservableAnchor{base: base, value: base.Value()}
+}
+
+// Value returns the cached value of the base. A dirty anchor is updated first.
+func (anchor *ObservableAnchor) Value() float32 {
+	if anchor.dirty {
+		anchor.Update()
+	}
+	return anchor.value
+}
+
+// RequestValue forwards the request to the base and notifies about a resulting change.
+func (anchor *ObservableAnchor) RequestValue(newValue float32) {
+	anchor.base.RequestValue(newValue)
+	anchor.Invalidate()
+	anchor.Update()
+}
+
+// Dependencies returns the base anchor.
+func (anchor *ObservableAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.base}
+}
+
+// Invalidate marks the cached value as outdated.
+func (anchor *ObservableAnchor) Invalidate() {
+	anchor.dirty = true
+}
+
+// IsDirty returns true if the cached value is outdated.
+func (anchor *ObservableAnchor) IsDirty() bool {
+	return anchor.dirty
+}
+
+// Update reads the value of the base, if the anchor is dirty, and notifies the listeners
+// if the value changed.
+func (anchor *ObservableAnchor) Update() {
+	if anchor.dirty {
+		newValue := anchor.base.Value()
+
+		anchor.dirty = false
+		if newValue != anchor.value {
+			anchor.value = newValue
+			listeners := make([]*AnchorChangeSubscription, len(anchor.listeners))
+			copy(listeners, anchor.listeners)
+			for _, subscription := range listeners {
+				subscription.listener(anchor, newValue)
+			}
+		}
+	}
+}
+
+// AddChangeListener registers given listener, which is called after every change of the value.
+// The returned subscription can be used to remove the listener again.
+func (anchor *ObservableAnchor) AddChangeListener(listener AnchorChangeListener) *AnchorChangeSubscription {
+	subscription := &AnchorChangeSubscription{listener: listener}
+
+	anchor.listeners = append(anchor.listeners, subscription)
+	if anchor.stopObservingBase == nil {
+		anchor.stopObservingBase = observeChanges([]Anchor{anchor.base}, anchor.onBaseChange)
+	}
+
+	return subscription
+}
+
+// RemoveChangeListener unregisters the listener of given subscription.
+func (anchor *ObservableAnchor) RemoveChangeListener(subscription *AnchorChangeSubscription) {
+	remaining := []*AnchorChangeSubscription{}
+
+	for _, other := range anchor.listeners {
+		if other != subscription {
+			remaining = append(remaining, other)
+		}
+	}
+	anchor.listeners = remaining
+	if (len(remaining) == 0) && (anchor.stopObservingBase != nil) {
+		anchor.stopObservingBase()
+		anchor.stopObservingBase = nil
+	}
+}
+
+func (anchor *ObservableAnchor) onBaseChange() {
+	anchor.Invalidate()
+	anchor.Update()
+}
+
+func (anchor *ObservableAnchor) subscribe(callback func()) (unsubscribe func()) {
+	subscription := anchor.AddChangeListener(func(*ObservableAnchor, float32) { callback() })
+
+	return func() { anchor.RemoveChangeListener(subscription) }
+}
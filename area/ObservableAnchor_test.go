@@ -0,0 +1,101 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type ObservableAnchorSuite struct {
+}
+
+var _ = check.Suite(&ObservableAnchorSuite{})
+
+func (suite *ObservableAnchorSuite) TestValueReturnsValueOfBase(c *check.C) {
+	anchor := NewObservableAnchor(NewAbsoluteAnchor(10.0))
+
+	c.Check(anchor.Value(), check.Equals, float32(10.0))
+}
+
+func (suite *ObservableAnchorSuite) TestValueIsCachedUntilInvalidated(c *check.C) {
+	base := NewAbsoluteAnchor(10.0)
+	anchor := NewObservableAnchor(base)
+
+	base.RequestValue(20.0)
+	c.Check(anchor.Value(), check.Equals, float32(10.0))
+	c.Check(anchor.IsDirty(), check.Equals, false)
+
+	anchor.Invalidate()
+	c.Check(anchor.IsDirty(), check.Equals, true)
+	c.Check(anchor.Value(), check.Equals, float32(20.0))
+	c.Check(anchor.IsDirty(), check.Equals, false)
+}
+
+func (suite *ObservableAnchorSuite) TestRequestValueNotifiesListeners(c *check.C) {
+	anchor := NewObservableAnchor(NewAbsoluteAnchor(10.0))
+	var notified []float32
+	anchor.AddChangeListener(func(changed *ObservableAnchor, newValue float32) {
+		notified = append(notified, newValue)
+	})
+
+	anchor.RequestValue(30.0)
+
+	c.Check(notified, check.DeepEquals, []float32{30.0})
+}
+
+func (suite *ObservableAnchorSuite) TestUpdateWithoutChangeDoesNotNotify(c *check.C) {
+	anchor := NewObservableAnchor(NewAbsoluteAnchor(10.0))
+	notified := false
+	anchor.AddChangeListener(func(*ObservableAnchor, float32) { notified = true })
+
+	anchor.Invalidate()
+	anchor.Update()
+
+	c.Check(notified, check.Equals, false)
+}
+
+func (suite *ObservableAnchorSuite) TestRemovedListenerIsNotNotified(c *check.C) {
+	anchor := NewObservableAnchor(NewAbsoluteAnchor(10.0))
+	notified := false
+	subscription := anchor.AddChangeListener(func(*ObservableAnchor, float32) { notified = true })
+
+	anchor.RemoveChangeListener(subscription)
+	anchor.RequestValue(20.0)
+
+	c.Check(notified, check.Equals, false)
+}
+
+func (suite *ObservableAnchorSuite) TestChangesOfObservableBasePropagate(c *check.C) {
+	base := NewObservableAnchor(NewAbsoluteAnchor(10.0))
+	anchor := NewObservableAnchor(base)
+	var notified []float32
+	anchor.AddChangeListener(func(changed *ObservableAnchor, newValue float32) {
+		notified = append(notified, newValue)
+	})
+
+	base.RequestValue(15.0)
+
+	c.Check(notified, check.DeepEquals, []float32{15.0})
+	c.Check(anchor.Value(), check.Equals, float32(15.0))
+}
+
+func (suite *ObservableAnchorSuite) TestChangesWithinChainOfBaseAreDetectedWhileListening(c *check.C) {
+	root := NewAbsoluteAnchor(10.0)
+	anchor := NewObservableAnchor(NewOffsetAnchor(root, 5.0))
+	var notified []float32
+	anchor.AddChangeListener(func(changed *ObservableAnchor, newValue float32) {
+		notified = append(notified, newValue)
+	})
+
+	root.RequestValue(20.0)
+
+	c.Check(notified, check.DeepEquals, []float32{25.0})
+}
+
+func (suite *ObservableAnchorSuite) TestBaseIsReleasedWithLastListener(c *check.C) {
+	base := NewAbsoluteAnchor(10.0)
+	anchor := NewObservableAnchor(base)
+	subscription := anchor.AddChangeListener(func(*ObservableAnchor, float32) {})
+
+	c.Check(len(base.(*absoluteAnchor).subscriptions), check.Equals, 1)
+	anchor.RemoveChangeListener(subscription)
+	c.Check(len(base.(*absoluteAnchor).subscriptions), check.Equals, 0)
+}
@@ -1,6 +1,7 @@
 package area
 
 type offsetAnchor struct {
+	changeNotifier
 	base   Anchor
 	offset float32
 }
@@ -17,5 +18,14 @@ func (anchor *offsetAnchor) Value() float32 {
 }
 
 func (anchor *offsetAnchor) RequestValue(newValue float32) {
-	anchor.offset = newValue - anchor.base.Value()
+	newOffset := newValue - anchor.base.Value()
+
+	if newOffset != anchor.offset {
+		anchor.offset = newOffset
+		anchor.notify()
+	}
+}
+
+func (anchor *offsetAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.base}
 }
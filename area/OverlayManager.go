@@ -0,0 +1,142 @@
+package area
+
+import (
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/input"
+)
+
+// overlayZIndex is the stacking level of overlay layers among the children of the root area.
+const overlayZIndex = 1 << 16
+
+// OverlayCloseHandler is called after an overlay layer was closed.
+type OverlayCloseHandler func()
+
+type overlayLayer struct {
+	area          *Area
+	previousFocus *Area
+	closeHandler  OverlayCloseHandler
+}
+
+// OverlayManager maintains modal and popup layers on top of a root area.
+// While a layer is open, it covers the root area and blocks all events to the areas beneath.
+// Pressing the escape key closes the topmost layer.
+type OverlayManager struct {
+	root       *Area
+	background RenderFunction
+	layers     []*overlayLayer
+}
+
+// NewOverlayManager returns a new overlay manager for the given root area.
+func NewOverlayManager(root *Area) *OverlayManager {
+	manager := &OverlayManager{root: root, background: func(*Area) {}}
+
+	root.AddEventHandler(events.KeyEventType, manager.onKey)
+	root.AddEventHandler(events.CharEventType, manager.onChar)
+
+	return manager
+}
+
+// SetBackgroundRenderer sets the function that renders each modal layer before its content,
+// for instance to dim the areas beneath. Default: renders nothing
+func (manager *OverlayManager) SetBackgroundRenderer(renderer RenderFunction) {
+	manager.background = renderer
+}
+
+// IsModalOpen returns true if at least one modal or popup layer is open.
+func (manager *OverlayManager) IsModalOpen() bool {
+	return len(manager.layers) > 0
+}
+
+// OpenModal creates a new modal layer on top of any previous one and returns its area.
+// The content of the modal is to be added as children of the returned area, which receives the focus.
+// The given handler is called after the layer was closed; it may be nil.
+func (manager *OverlayManager) OpenModal(closeHandler OverlayCloseHandler) *Area {
+	return manager.open(closeHandler, false)
+}
+
+// OpenPopup creates a new popup layer on top of any previous one and returns its area.
+// A popup layer blocks events like a modal layer, yet it renders no background and
+// closes when a mouse button is pressed outside of its content.
+func (manager *OverlayManager) OpenPopup(closeHandler OverlayCloseHandler) *Area {
+	return manager.open(closeHandler, true)
+}
+
+func (manager *OverlayManager) open(closeHandler OverlayCloseHandler, popup bool) *Area {
+	builder := NewAreaBuilder()
+	layer := &overlayLayer{previousFocus: manager.root.focusTarget(), closeHandler: closeHandler}
+
+	builder.SetParent(manager.root)
+	builder.SetLeft(manager.root.Left())
+	builder.SetTop(manager.root.Top())
+	builder.SetRight(manager.root.Right())
+	builder.SetBottom(manager.root.Bottom())
+	builder.SetZIndex(overlayZIndex)
+	builder.SetModal(true)
+	for _, eventType := range []events.EventType{
+		events.MouseMoveEventType, events.MouseButtonUpEventType, events.MouseScrollEventType,
+		events.MouseButtonClickedEventType, events.MouseButtonDoubleClickedEventType} {
+		builder.OnEvent(eventType, SilentConsumer)
+	}
+	if popup {
+		builder.OnEvent(events.MouseButtonDownEventType, func(area *Area, event events.Event) bool {
+			manager.Close(area)
+			return true
+		})
+	} else {
+		builder.OnRender(func(area *Area) { manager.background(area) })
+		builder.OnEvent(events.MouseButtonDownEventType, SilentConsumer)
+	}
+	layer.area = builder.Build()
+	manager.layers = append(manager.layers, layer)
+	layer.area.RequestFocus()
+
+	return layer.area
+}
+
+// Close removes the given modal layer, and any layer opened after it. The focus returns to
+// the area that had it before the layer was opened. Closing an unknown area has no effect.
+func (manager *OverlayManager) Close(area *Area) {
+	for index := len(manager.layers) - 1; index >= 0; index-- {
+		if manager.layers[index].area == area {
+			for len(manager.layers) > index {
+				manager.closeTop()
+			}
+		}
+	}
+}
+
+func (manager *OverlayManager) closeTop() {
+	last := len(manager.layers) - 1
+	layer := manager.layers[last]
+
+	manager.layers = manager.layers[:last]
+	layer.area.Remove()
+	if (layer.previousFocus != manager.root) && (layer.previousFocus.Root() == manager.root) {
+		layer.previousFocus.RequestFocus()
+	}
+	if layer.closeHandler != nil {
+		layer.closeHandler()
+	}
+}
+
+func (manager *OverlayManager) onKey(area *Area, event events.Event) (consumed bool) {
+	if manager.IsModalOpen() {
+		keyEvent := event.(*events.KeyEvent)
+
+		switch keyEvent.Key() {
+		case input.KeyEscape:
+			manager.closeTop()
+			consumed = true
+		case input.KeyTab:
+			consumed = false
+		default:
+			consumed = true
+		}
+	}
+
+	return
+}
+
+func (manager *OverlayManager) onChar(area *Area, event events.Event) bool {
+	return manager.IsModalOpen()
+}
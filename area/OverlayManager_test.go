@@ -0,0 +1,138 @@
+package area
+
+import (
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/input"
+
+	check "gopkg.in/check.v1"
+)
+
+type OverlayManagerSuite struct {
+	root    *Area
+	manager *OverlayManager
+}
+
+var _ = check.Suite(&OverlayManagerSuite{})
+
+func (suite *OverlayManagerSuite) SetUpTest(c *check.C) {
+	rootBuilder := NewAreaBuilder()
+	rootBuilder.SetRight(NewAbsoluteAnchor(100.0))
+	rootBuilder.SetBottom(NewAbsoluteAnchor(100.0))
+	suite.root = rootBuilder.Build()
+	suite.manager = NewOverlayManager(suite.root)
+}
+
+func (suite *OverlayManagerSuite) aConsumingArea(parent *Area, consumed *[]events.EventType) *Area {
+	builder := NewAreaBuilder()
+	builder.SetParent(parent)
+	builder.SetRight(parent.Right())
+	builder.SetBottom(parent.Bottom())
+	builder.SetFocusable(true)
+	for _, eventType := range []events.EventType{events.MouseButtonDownEventType, events.KeyEventType} {
+		builder.OnEvent(eventType, func(area *Area, event events.Event) bool {
+			*consumed = append(*consumed, event.EventType())
+			return true
+		})
+	}
+	return builder.Build()
+}
+
+func (suite *OverlayManagerSuite) TestOpenModalBlocksPositionalEventsBeneath(c *check.C) {
+	var consumed []events.EventType
+	suite.aConsumingArea(suite.root, &consumed)
+
+	suite.manager.OpenModal(nil)
+	result := suite.root.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
+		10.0, 10.0, 0, input.MousePrimary, input.MousePrimary))
+
+	c.Check(result, check.Equals, true)
+	c.Check(consumed, check.IsNil)
+}
+
+func (suite *OverlayManagerSuite) TestOpenModalTakesFocusFromAreasBeneath(c *check.C) {
+	var consumed []events.EventType
+	beneath := suite.aConsumingArea(suite.root, &consumed)
+	beneath.RequestFocus()
+
+	suite.manager.OpenModal(nil)
+	suite.root.HandleEvent(events.NewKeyEvent(input.KeyEnter, input.ModNone))
+
+	c.Check(beneath.HasFocus(), check.Equals, false)
+	c.Check(consumed, check.IsNil)
+}
+
+func (suite *OverlayManagerSuite) TestContentOfModalReceivesEvents(c *check.C) {
+	var consumed []events.EventType
+	layer := suite.manager.OpenModal(nil)
+	suite.aConsumingArea(layer, &consumed)
+
+	suite.root.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
+		10.0, 10.0, 0, input.MousePrimary, input.MousePrimary))
+
+	c.Check(consumed, check.DeepEquals, []events.EventType{events.MouseButtonDownEventType})
+}
+
+func (suite *OverlayManagerSuite) TestEscapeClosesTopmostModal(c *check.C) {
+	closed := []string{}
+	suite.manager.OpenModal(func() { closed = append(closed, "first") })
+	suite.manager.OpenModal(func() { closed = append(closed, "second") })
+
+	suite.root.HandleEvent(events.NewKeyEvent(input.KeyEscape, input.ModNone))
+
+	c.Check(closed, check.DeepEquals, []string{"second"})
+	c.Check(suite.manager.IsModalOpen(), check.Equals, true)
+}
+
+func (suite *OverlayManagerSuite) TestCloseRemovesLayersOpenedAfterwards(c *check.C) {
+	closed := []string{}
+	first := suite.manager.OpenModal(func() { closed = append(closed, "first") })
+	suite.manager.OpenModal(func() { closed = append(closed, "second") })
+
+	suite.manager.Close(first)
+
+	c.Check(closed, check.DeepEquals, []string{"second", "first"})
+	c.Check(suite.manager.IsModalOpen(), check.Equals, false)
+	c.Check(len(suite.root.children), check.Equals, 0)
+}
+
+func (suite *OverlayManagerSuite) TestCloseRestoresPreviousFocus(c *check.C) {
+	var consumed []events.EventType
+	beneath := suite.aConsumingArea(suite.root, &consumed)
+	beneath.RequestFocus()
+
+	layer := suite.manager.OpenModal(nil)
+	suite.manager.Close(layer)
+
+	c.Check(beneath.HasFocus(), check.Equals, true)
+}
+
+func (suite *OverlayManagerSuite) TestTabKeyIsNotConsumedWhileModalIsOpen(c *check.C) {
+	suite.manager.OpenModal(nil)
+
+	result := suite.root.HandleEvent(events.NewKeyEvent(input.KeyTab, input.ModNone))
+
+	c.Check(result, check.Equals, false)
+}
+
+func (suite *OverlayManagerSuite) TestPressOutsideOfPopupContentClosesPopup(c *check.C) {
+	closed := false
+	suite.manager.OpenPopup(func() { closed = true })
+
+	result := suite.root.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
+		10.0, 10.0, 0, input.MousePrimary, input.MousePrimary))
+
+	c.Check(result, check.Equals, true)
+	c.Check(closed, check.Equals, true)
+	c.Check(suite.manager.IsModalOpen(), check.Equals, false)
+}
+
+func (suite *OverlayManagerSuite) TestPressOnPopupContentKeepsPopupOpen(c *check.C) {
+	var consumed []events.EventType
+	layer := suite.manager.OpenPopup(nil)
+	suite.aConsumingArea(layer, &consumed)
+
+	suite.root.DispatchPositionalEvent(events.NewMouseButtonEvent(events.MouseButtonDownEventType,
+		10.0, 10.0, 0, input.MousePrimary, input.MousePrimary))
+
+	c.Check(suite.manager.IsModalOpen(), check.Equals, true)
+}
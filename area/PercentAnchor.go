@@ -0,0 +1,8 @@
+package area
+
+// NewPercentAnchor returns an anchor which derives a value from a percentage
+// of the distance between two other anchors.
+// Requests to set a new value update the percentage.
+func NewPercentAnchor(from, to Anchor, percent float32) Anchor {
+	return NewRelativeAnchor(from, to, percent/100.0)
+}
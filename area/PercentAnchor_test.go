@@ -0,0 +1,37 @@
+package area
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+type PercentAnchorSuite struct {
+}
+
+var _ = check.Suite(&PercentAnchorSuite{})
+
+func (suite *PercentAnchorSuite) TestValueReturnsInitialValue_A(c *check.C) {
+	from := NewAbsoluteAnchor(10.0)
+	to := NewAbsoluteAnchor(20.0)
+	anchor := NewPercentAnchor(from, to, 50.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(15.0))
+}
+
+func (suite *PercentAnchorSuite) TestValueReturnsInitialValue_B(c *check.C) {
+	from := NewAbsoluteAnchor(0.0)
+	to := NewAbsoluteAnchor(200.0)
+	anchor := NewPercentAnchor(from, to, 25.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(50.0))
+}
+
+func (suite *PercentAnchorSuite) TestRequestValueUpdatesPercentage(c *check.C) {
+	from := NewAbsoluteAnchor(0.0)
+	to := NewAbsoluteAnchor(100.0)
+	anchor := NewPercentAnchor(from, to, 10.0)
+
+	anchor.RequestValue(50.0)
+	to.RequestValue(200.0)
+
+	c.Check(anchor.Value(), check.Equals, float32(100.0))
+}
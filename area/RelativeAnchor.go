@@ -1,6 +1,7 @@
 package area
 
 type relativeAnchor struct {
+	changeNotifier
 	from     Anchor
 	to       Anchor
 	fraction float32
@@ -24,6 +25,15 @@ func (anchor *relativeAnchor) RequestValue(newValue float32) {
 	toValue := anchor.to.Value()
 
 	if fromValue != toValue {
-		anchor.fraction = (newValue - fromValue) / (toValue - fromValue)
+		newFraction := (newValue - fromValue) / (toValue - fromValue)
+
+		if newFraction != anchor.fraction {
+			anchor.fraction = newFraction
+			anchor.notify()
+		}
 	}
 }
+
+func (anchor *relativeAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.from, anchor.to}
+}
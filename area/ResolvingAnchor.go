@@ -28,3 +28,7 @@ func (anchor *resolvingAnchor) Value() float32 {
 func (anchor *resolvingAnchor) RequestValue(newValue float32) {
 	anchor.resolved().RequestValue(newValue)
 }
+
+func (anchor *resolvingAnchor) Dependencies() []Anchor {
+	return []Anchor{anchor.resolved()}
+}
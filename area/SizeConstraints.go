@@ -0,0 +1,30 @@
+package area
+
+// SizeConstraints limit the size of an area. Zero values mean no constraint.
+type SizeConstraints struct {
+	// MinWidth is the minimum width.
+	MinWidth float32
+	// MaxWidth is the maximum width.
+	MaxWidth float32
+	// MinHeight is the minimum height.
+	MinHeight float32
+	// MaxHeight is the maximum height.
+	MaxHeight float32
+	// AspectRatio is the ratio of width to height. If set, the height is derived from the width.
+	// Height limits take precedence over the aspect ratio.
+	AspectRatio float32
+}
+
+// apply returns the right and bottom anchors that satisfy the constraints.
+func (constraints SizeConstraints) apply(left, top, right, bottom Anchor) (Anchor, Anchor) {
+	if (constraints.MinWidth > 0) || (constraints.MaxWidth > 0) {
+		right = NewExtentLimitedAnchor(left, right, constraints.MinWidth, constraints.MaxWidth)
+	}
+	if constraints.AspectRatio > 0 {
+		bottom = NewAspectRatioAnchor(top, left, right, 1.0/constraints.AspectRatio)
+	}
+	if (constraints.MinHeight > 0) || (constraints.MaxHeight > 0) {
+		bottom = NewExtentLimitedAnchor(top, bottom, constraints.MinHeight, constraints.MaxHeight)
+	}
+	return right, bottom
+}
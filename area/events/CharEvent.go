@@ -0,0 +1,38 @@
+package events
+
+import (
+	"github.com/dertseha/jellui/input"
+)
+
+// CharEvent describes a typed character.
+type CharEvent struct {
+	char     rune
+	modifier input.Modifier
+}
+
+// CharEventType is the name for events where a character was typed.
+const CharEventType = EventType("keyboard.char")
+
+// NewCharEvent returns a new instance of a char event.
+func NewCharEvent(char rune, modifier input.Modifier) *CharEvent {
+	event := &CharEvent{
+		char:     char,
+		modifier: modifier}
+
+	return event
+}
+
+// EventType implements the Event interface.
+func (event *CharEvent) EventType() EventType {
+	return CharEventType
+}
+
+// Char returns the typed character.
+func (event *CharEvent) Char() rune {
+	return event.char
+}
+
+// Modifier returns the modifier that was active while the character was typed.
+func (event *CharEvent) Modifier() input.Modifier {
+	return event.modifier
+}
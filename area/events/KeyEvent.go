@@ -0,0 +1,38 @@
+package events
+
+import (
+	"github.com/dertseha/jellui/input"
+)
+
+// KeyEvent describes a pressed named key on the keyboard.
+type KeyEvent struct {
+	key      input.Key
+	modifier input.Modifier
+}
+
+// KeyEventType is the name for events where a named key was pressed.
+const KeyEventType = EventType("keyboard.key")
+
+// NewKeyEvent returns a new instance of a key event.
+func NewKeyEvent(key input.Key, modifier input.Modifier) *KeyEvent {
+	event := &KeyEvent{
+		key:      key,
+		modifier: modifier}
+
+	return event
+}
+
+// EventType implements the Event interface.
+func (event *KeyEvent) EventType() EventType {
+	return KeyEventType
+}
+
+// Key returns the pressed key.
+func (event *KeyEvent) Key() input.Key {
+	return event.key
+}
+
+// Modifier returns the modifier that was active while the key was pressed.
+func (event *KeyEvent) Modifier() input.Modifier {
+	return event.modifier
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"github.com/dertseha/jellui/input"
+)
+
+// ModifierEvent describes a change of the currently active keyboard modifier.
+type ModifierEvent struct {
+	modifier input.Modifier
+}
+
+// ModifierEventType is the name for events where the active modifier changed.
+const ModifierEventType = EventType("keyboard.modifier")
+
+// NewModifierEvent returns a new instance of a modifier event.
+func NewModifierEvent(modifier input.Modifier) *ModifierEvent {
+	event := &ModifierEvent{modifier: modifier}
+
+	return event
+}
+
+// EventType implements the Event interface.
+func (event *ModifierEvent) EventType() EventType {
+	return ModifierEventType
+}
+
+// Modifier returns the now active modifier.
+func (event *ModifierEvent) Modifier() input.Modifier {
+	return event.modifier
+}
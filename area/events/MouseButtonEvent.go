@@ -16,6 +16,9 @@ const MouseButtonUpEventType = EventType("mouse.button.up")
 // MouseButtonClickedEventType is the name for events where buttons where clicked without moving the cursor.
 const MouseButtonClickedEventType = EventType("mouse.button.clicked")
 
+// MouseButtonDoubleClickedEventType is the name for events where buttons where clicked twice in short succession.
+const MouseButtonDoubleClickedEventType = EventType("mouse.button.doubleclicked")
+
 // NewMouseButtonEvent returns a new instance of a mouse event.
 func NewMouseButtonEvent(eventType EventType, x, y float32, modifier uint32, buttons uint32, affectedButtons uint32) *MouseButtonEvent {
 	event := &MouseButtonEvent{
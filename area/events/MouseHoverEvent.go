@@ -0,0 +1,20 @@
+package events
+
+// MouseHoverEvent describes the cursor entering or leaving an area.
+type MouseHoverEvent struct {
+	MouseEvent
+}
+
+// MouseEnterEventType is the name for events where the cursor entered an area.
+const MouseEnterEventType = EventType("mouse.enter")
+
+// MouseLeaveEventType is the name for events where the cursor left an area.
+const MouseLeaveEventType = EventType("mouse.leave")
+
+// NewMouseHoverEvent returns a new instance of a mouse event.
+func NewMouseHoverEvent(eventType EventType, x, y float32, modifier uint32, buttons uint32) *MouseHoverEvent {
+	event := &MouseHoverEvent{
+		MouseEvent: InitMouseEvent(eventType, x, y, modifier, buttons)}
+
+	return event
+}
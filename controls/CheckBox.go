@@ -0,0 +1,145 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// CheckState is the state of a check box.
+type CheckState int
+
+const (
+	// Unchecked is the state of a cleared check box.
+	Unchecked = CheckState(0)
+	// Checked is the state of a set check box.
+	Checked = CheckState(1)
+	// Indeterminate is the third state of a tri-state check box, neither set nor cleared.
+	Indeterminate = CheckState(2)
+)
+
+// CheckBoxChangeHandler is a callback for notifying the current state.
+type CheckBoxChangeHandler func(state CheckState)
+
+const checkBoxGlyphMargin = float32(2.0)
+
+// CheckBox is a control for toggling an option, with a text describing the option.
+type CheckBox struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+
+	glyphLabel *Label
+	textLabel  *Label
+
+	checkBoxChangeHandler CheckBoxChangeHandler
+
+	triState bool
+	state    CheckState
+	pressed  bool
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (box *CheckBox) Dispose() {
+	box.glyphLabel.Dispose()
+	box.textLabel.Dispose()
+	box.area.Remove()
+}
+
+// State returns the current state.
+func (box *CheckBox) State() CheckState {
+	return box.state
+}
+
+// SetState updates the current state. Does not fire the change handler.
+func (box *CheckBox) SetState(state CheckState) {
+	box.state = state
+	switch state {
+	case Checked:
+		box.glyphLabel.SetText("x")
+	case Indeterminate:
+		box.glyphLabel.SetText("-")
+	default:
+		box.glyphLabel.SetText("")
+	}
+}
+
+// SetText sets the text describing the option.
+func (box *CheckBox) SetText(text string) {
+	box.textLabel.SetText(text)
+}
+
+func (box *CheckBox) glyphBounds() (left, top, right, bottom float32) {
+	left, top = box.area.Left().Value(), box.area.Top().Value()
+	size := box.area.Bottom().Value() - top
+
+	return left + checkBoxGlyphMargin, top + checkBoxGlyphMargin, left + size - checkBoxGlyphMargin, top + size - checkBoxGlyphMargin
+}
+
+func (box *CheckBox) onRender(area *area.Area) {
+	left, top, right, bottom := box.glyphBounds()
+
+	box.rectRenderer.Fill(left, top, right, bottom, graphics.RGBA(0.31, 0.56, 0.34, 0.8))
+	renderOutline(box.rectRenderer, left, top, right, bottom, graphics.RGBA(0.5, 0.58, 0.33, 1.0))
+}
+
+func (box *CheckBox) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !box.area.Contains(mouseEvent.Position()) {
+		box.pressed = false
+		area.ReleaseFocus()
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		area.RequestFocus()
+		box.pressed = true
+		consumed = true
+	}
+
+	return
+}
+
+func (box *CheckBox) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if box.pressed && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		box.pressed = false
+		if box.area.Contains(mouseEvent.Position()) {
+			box.toggle()
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (box *CheckBox) onChar(area *area.Area, event events.Event) (consumed bool) {
+	charEvent := event.(*events.CharEvent)
+
+	if charEvent.Char() == ' ' {
+		box.toggle()
+		consumed = true
+	}
+
+	return
+}
+
+// toggle advances to the next state. Tri-state boxes cycle from checked to indeterminate.
+func (box *CheckBox) toggle() {
+	newState := Checked
+
+	if (box.state == Checked) && box.triState {
+		newState = Indeterminate
+	} else if box.state != Unchecked {
+		newState = Unchecked
+	}
+	box.SetState(newState)
+	box.checkBoxChangeHandler(newState)
+}
+
+// renderOutline draws a one pixel frame just inside the given rectangle.
+func renderOutline(renderer *graphics.RectangleRenderer, left, top, right, bottom float32, color graphics.Color) {
+	renderer.Fill(left, top, right, top+1, color)
+	renderer.Fill(left, bottom-1, right, bottom, color)
+	renderer.Fill(left, top+1, left+1, bottom-1, color)
+	renderer.Fill(right-1, top+1, right, bottom-1, color)
+}
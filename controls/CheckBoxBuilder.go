@@ -0,0 +1,137 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// CheckBoxBuilder is a builder for CheckBox instances.
+type CheckBoxBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	checkBoxChangeHandler CheckBoxChangeHandler
+
+	text     string
+	triState bool
+	state    CheckState
+}
+
+// NewCheckBoxBuilder returns a new CheckBoxBuilder instance.
+func NewCheckBoxBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer) *CheckBoxBuilder {
+	builder := &CheckBoxBuilder{
+		areaBuilder:           area.NewAreaBuilder(),
+		rectRenderer:          rectRenderer,
+		labelBuilder:          labelBuilder,
+		checkBoxChangeHandler: func(CheckState) {}}
+
+	return builder
+}
+
+// Build creates a new CheckBox instance from the current parameters.
+// The glyph is a square at the left, as high as the check box.
+func (builder *CheckBoxBuilder) Build() *CheckBox {
+	box := &CheckBox{
+		rectRenderer:          builder.rectRenderer,
+		checkBoxChangeHandler: builder.checkBoxChangeHandler,
+		triState:              builder.triState}
+
+	builder.areaBuilder.OnRender(box.onRender)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, box.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, box.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.CharEventType, box.onChar)
+	builder.areaBuilder.SetFocusable(true)
+	box.area = builder.areaBuilder.Build()
+
+	glyphRight := area.NewAspectRatioAnchor(box.area.Left(), box.area.Top(), box.area.Bottom(), 1.0)
+
+	builder.labelBuilder.SetParent(box.area)
+	builder.labelBuilder.SetLeft(area.NewOffsetAnchor(box.area.Left(), checkBoxGlyphMargin))
+	builder.labelBuilder.SetTop(area.NewOffsetAnchor(box.area.Top(), checkBoxGlyphMargin))
+	builder.labelBuilder.SetRight(area.NewOffsetAnchor(glyphRight, -checkBoxGlyphMargin))
+	builder.labelBuilder.SetBottom(area.NewOffsetAnchor(box.area.Bottom(), -checkBoxGlyphMargin))
+	builder.labelBuilder.AlignedHorizontallyBy(CenterAligner)
+	box.glyphLabel = builder.labelBuilder.Build()
+
+	builder.labelBuilder.SetLeft(area.NewOffsetAnchor(glyphRight, 4))
+	builder.labelBuilder.SetTop(box.area.Top())
+	builder.labelBuilder.SetRight(box.area.Right())
+	builder.labelBuilder.SetBottom(box.area.Bottom())
+	builder.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	box.textLabel = builder.labelBuilder.Build()
+
+	box.SetText(builder.text)
+	box.SetState(builder.state)
+
+	return box
+}
+
+// SetParent sets the parent area.
+func (builder *CheckBoxBuilder) SetParent(parent *area.Area) *CheckBoxBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *CheckBoxBuilder) SetLeft(value area.Anchor) *CheckBoxBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *CheckBoxBuilder) SetTop(value area.Anchor) *CheckBoxBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *CheckBoxBuilder) SetRight(value area.Anchor) *CheckBoxBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *CheckBoxBuilder) SetBottom(value area.Anchor) *CheckBoxBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *CheckBoxBuilder) SetTabIndex(value int) *CheckBoxBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the box. Default: ""
+func (builder *CheckBoxBuilder) SetTooltip(text string) *CheckBoxBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithText sets the text describing the option.
+func (builder *CheckBoxBuilder) WithText(value string) *CheckBoxBuilder {
+	builder.text = value
+	return builder
+}
+
+// WithState sets the initial state. Default: Unchecked
+func (builder *CheckBoxBuilder) WithState(value CheckState) *CheckBoxBuilder {
+	builder.state = value
+	return builder
+}
+
+// WithTriState determines whether toggling the box cycles through the Indeterminate state as well.
+// Default: false
+func (builder *CheckBoxBuilder) WithTriState(value bool) *CheckBoxBuilder {
+	builder.triState = value
+	return builder
+}
+
+// WithCheckBoxChangeHandler sets the handler that is called when the user changes the state.
+func (builder *CheckBoxBuilder) WithCheckBoxChangeHandler(handler CheckBoxChangeHandler) *CheckBoxBuilder {
+	builder.checkBoxChangeHandler = handler
+	return builder
+}
@@ -0,0 +1,21 @@
+package controls
+
+// Clipboard provides access to textual content shared between applications.
+type Clipboard interface {
+	// ClipboardString returns the current text of the clipboard.
+	ClipboardString() (string, error)
+	// SetClipboardString sets the text of the clipboard.
+	SetClipboardString(value string)
+}
+
+type localClipboard struct {
+	value string
+}
+
+func (clipboard *localClipboard) ClipboardString() (string, error) {
+	return clipboard.value, nil
+}
+
+func (clipboard *localClipboard) SetClipboardString(value string) {
+	clipboard.value = value
+}
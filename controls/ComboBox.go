@@ -16,7 +16,10 @@ type ComboBoxItem interface{}
 type SelectionChangeHandler func(item ComboBoxItem)
 
 // ComboBox provides the ability to select one item from a list.
+// The list is opened in a popup layer if the box has an overlay manager,
+// otherwise as a child of the root area.
 type ComboBox struct {
+	overlays     *area.OverlayManager
 	labelBuilder *LabelBuilder
 
 	area         *area.Area
@@ -30,10 +33,12 @@ type ComboBox struct {
 	items        []ComboBoxItem
 	selectedItem ComboBoxItem
 
+	listLayer      *area.Area
 	listArea       *area.Area
 	listItemCount  int
 	listItemLabels []*Label
 	listStartIndex int
+	highlightIndex int
 }
 
 // Dispose releases the resources.
@@ -71,7 +76,9 @@ func (box *ComboBox) onRender(area *area.Area) {
 func (box *ComboBox) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
 	mouseEvent := event.(*events.MouseButtonEvent)
 
-	if mouseEvent.Buttons() == input.MousePrimary {
+	if !area.Contains(mouseEvent.Position()) {
+		area.ReleaseFocus()
+	} else if mouseEvent.Buttons() == input.MousePrimary {
 		if box.listArea == nil {
 			box.showList()
 		} else {
@@ -83,17 +90,42 @@ func (box *ComboBox) onMouseDown(area *area.Area, event events.Event) (consumed
 	return
 }
 
-func (box *ComboBox) contains(area *area.Area, event events.PositionalEvent) bool {
-	x, y := event.Position()
+func (box *ComboBox) onMouseScroll(area *area.Area, event events.Event) bool {
+	return area.Contains(event.(*events.MouseScrollEvent).Position())
+}
+
+func (box *ComboBox) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	switch keyEvent.Key() {
+	case input.KeyDown, input.KeyUp, input.KeyEnter:
+		box.showList()
+		consumed = true
+	}
+
+	return
+}
+
+func (box *ComboBox) onChar(area *area.Area, event events.Event) (consumed bool) {
+	charEvent := event.(*events.CharEvent)
+
+	if charEvent.Char() == ' ' {
+		box.showList()
+		consumed = true
+	}
 
-	return (x >= area.Left().Value()) && (x < area.Right().Value()) &&
-		(y >= area.Top().Value()) && (y < area.Bottom().Value())
+	return
 }
 
 func (box *ComboBox) showList() {
 	if box.listArea == nil {
 		listAreaBuilder := area.NewAreaBuilder()
 		root := box.area.Root()
+		listParent := root
+		if box.overlays != nil {
+			box.listLayer = box.overlays.OpenPopup(box.onListClosed)
+			listParent = box.listLayer
+		}
 		boxTop := box.area.Top().Value()
 		boxBottom := box.area.Bottom().Value()
 		boxHeight := boxBottom - boxTop
@@ -110,7 +142,7 @@ func (box *ComboBox) showList() {
 			listTop = area.NewOffsetAnchor(box.area.Bottom(), 0)
 		}
 
-		listAreaBuilder.SetParent(root)
+		listAreaBuilder.SetParent(listParent)
 		listAreaBuilder.SetLeft(box.area.Left())
 		listAreaBuilder.SetRight(box.area.Right())
 		listAreaBuilder.SetTop(listTop)
@@ -120,6 +152,7 @@ func (box *ComboBox) showList() {
 		listAreaBuilder.OnEvent(events.MouseButtonUpEventType, box.onListMouseUp)
 		listAreaBuilder.OnEvent(events.MouseScrollEventType, box.onListScroll)
 		listAreaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+		listAreaBuilder.OnEvent(events.KeyEventType, box.onListKey)
 
 		box.listArea = listAreaBuilder.Build()
 		box.listArea.RequestFocus()
@@ -138,30 +171,107 @@ func (box *ComboBox) showList() {
 			box.listItemLabels[listIndex] = box.labelBuilder.Build()
 			lastBottom = nextBottom
 		}
+		box.highlightIndex = 0
+		for index, item := range box.items {
+			if item == box.selectedItem {
+				box.highlightIndex = index
+			}
+		}
+		box.scrollToHighlight()
 		box.updateListItemLabels()
 	}
 }
 
 func (box *ComboBox) hideList() {
-	if box.listArea != nil {
+	if box.listLayer != nil {
+		box.overlays.Close(box.listLayer)
+	} else if box.listArea != nil {
 		box.listArea.Remove()
-		box.listArea = nil
-		for _, label := range box.listItemLabels {
-			label.Dispose()
-		}
-		box.listItemLabels = nil
+		box.onListClosed()
 	}
 }
 
+// onListClosed is called when the list was closed, for any reason.
+func (box *ComboBox) onListClosed() {
+	for _, label := range box.listItemLabels {
+		label.Dispose()
+	}
+	box.listItemLabels = nil
+	box.listArea = nil
+	box.listLayer = nil
+}
+
 func (box *ComboBox) updateListItemLabels() {
 	for listIndex, label := range box.listItemLabels {
 		label.SetText(fmt.Sprintf("%v", box.items[box.listStartIndex+listIndex]))
 	}
 }
 
+func (box *ComboBox) scrollToHighlight() {
+	if box.highlightIndex < box.listStartIndex {
+		box.listStartIndex = box.highlightIndex
+	} else if box.highlightIndex >= (box.listStartIndex + box.listItemCount) {
+		box.listStartIndex = box.highlightIndex - box.listItemCount + 1
+	}
+}
+
 func (box *ComboBox) onListRender(area *area.Area) {
-	box.rectRenderer.Fill(area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value(),
-		graphics.RGBA(0.31, 0.56, 0.34, 0.7))
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+	highlightRow := box.highlightIndex - box.listStartIndex
+
+	box.rectRenderer.Fill(areaLeft, areaTop, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.7))
+	if (highlightRow >= 0) && (highlightRow < box.listItemCount) {
+		rowHeight := (areaBottom - areaTop) / float32(box.listItemCount)
+		rowTop := areaTop + rowHeight*float32(highlightRow)
+		box.rectRenderer.Fill(areaLeft, rowTop, areaRight, rowTop+rowHeight, graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+	}
+}
+
+func (box *ComboBox) onListKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyUp:
+		box.moveHighlight(-1)
+	case input.KeyDown:
+		box.moveHighlight(1)
+	case input.KeyEnter:
+		if box.listItemCount > 0 {
+			chosenItem := box.items[box.highlightIndex]
+			box.closeListByKeyboard()
+			box.onItemChosen(chosenItem)
+		} else {
+			box.closeListByKeyboard()
+		}
+	case input.KeyEscape:
+		box.closeListByKeyboard()
+	case input.KeyTab:
+		box.closeListByKeyboard()
+		consumed = false
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (box *ComboBox) moveHighlight(delta int) {
+	newIndex := box.highlightIndex + delta
+
+	if (newIndex >= 0) && (newIndex < len(box.items)) {
+		box.highlightIndex = newIndex
+		box.scrollToHighlight()
+		box.updateListItemLabels()
+	}
+}
+
+func (box *ComboBox) closeListByKeyboard() {
+	box.hideList()
+	box.area.RequestFocus()
 }
 
 func (box *ComboBox) onListMouseDown(area *area.Area, event events.Event) (consumed bool) {
@@ -180,10 +290,10 @@ func (box *ComboBox) onListMouseUp(area *area.Area, event events.Event) (consume
 
 	if mouseEvent.AffectedButtons() == input.MousePrimary {
 		if box.listArea != nil {
-			if box.listArea.HasFocus() {
+			if (box.listLayer == nil) && box.listArea.HasFocus() {
 				box.listArea.ReleaseFocus()
 			}
-			if box.contains(box.listArea, mouseEvent) {
+			if box.listArea.Contains(mouseEvent.Position()) {
 				_, mouseY := mouseEvent.Position()
 				chosenItem := ((mouseY - box.listArea.Top().Value()) * float32(box.listItemCount)) /
 					(box.listArea.Bottom().Value() - box.listArea.Top().Value())
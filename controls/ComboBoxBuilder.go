@@ -8,6 +8,7 @@ import (
 
 // ComboBoxBuilder is a builder for ComboBox instances.
 type ComboBoxBuilder struct {
+	overlays     *area.OverlayManager
 	areaBuilder  *area.AreaBuilder
 	rectRenderer *graphics.RectangleRenderer
 	labelBuilder *LabelBuilder
@@ -31,6 +32,7 @@ func NewComboBoxBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.Recta
 // Build creates a new ComboBox instance from the current parameters.
 func (builder *ComboBoxBuilder) Build() *ComboBox {
 	box := &ComboBox{
+		overlays:               builder.overlays,
 		labelBuilder:           builder.labelBuilder,
 		rectRenderer:           builder.rectRenderer,
 		selectionChangeHandler: builder.selectionChangeHandler,
@@ -40,7 +42,10 @@ func (builder *ComboBoxBuilder) Build() *ComboBox {
 	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, box.onMouseDown)
 	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, area.SilentConsumer)
 	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
-	builder.areaBuilder.OnEvent(events.MouseScrollEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.MouseScrollEventType, box.onMouseScroll)
+	builder.areaBuilder.OnEvent(events.KeyEventType, box.onKey)
+	builder.areaBuilder.OnEvent(events.CharEventType, box.onChar)
+	builder.areaBuilder.SetFocusable(true)
 	box.area = builder.areaBuilder.Build()
 
 	builder.labelBuilder.SetParent(box.area)
@@ -92,6 +97,18 @@ func (builder *ComboBoxBuilder) SetBottom(value area.Anchor) *ComboBoxBuilder {
 	return builder
 }
 
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *ComboBoxBuilder) SetTabIndex(value int) *ComboBoxBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the box. Default: ""
+func (builder *ComboBoxBuilder) SetTooltip(text string) *ComboBoxBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
 // WithItems sets the list of contained items.
 func (builder *ComboBoxBuilder) WithItems(items []ComboBoxItem) *ComboBoxBuilder {
 	builder.items = make([]ComboBoxItem, len(items))
@@ -104,3 +121,10 @@ func (builder *ComboBoxBuilder) WithSelectionChangeHandler(handler SelectionChan
 	builder.selectionChangeHandler = handler
 	return builder
 }
+
+// WithOverlays sets the overlay manager in which the list of items is opened as a popup layer.
+// Without an overlay manager, the list is added to the root area. Default: nil
+func (builder *ComboBoxBuilder) WithOverlays(overlays *area.OverlayManager) *ComboBoxBuilder {
+	builder.overlays = overlays
+	return builder
+}
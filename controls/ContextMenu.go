@@ -0,0 +1,43 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/input"
+)
+
+// ContextMenu opens a menu at the cursor when the secondary mouse button is pressed on an area.
+type ContextMenu struct {
+	target       *area.Area
+	menuBuilder  *MenuBuilder
+	subscription *area.Subscription
+}
+
+// NewContextMenu registers a context menu for the given target area.
+// The menu is built from the given builder each time it is opened.
+func NewContextMenu(target *area.Area, menuBuilder *MenuBuilder) *ContextMenu {
+	contextMenu := &ContextMenu{target: target, menuBuilder: menuBuilder}
+
+	contextMenu.subscription = target.AddEventHandler(events.MouseButtonDownEventType, contextMenu.onMouseDown)
+
+	return contextMenu
+}
+
+// Dispose removes the context menu from the target area.
+func (contextMenu *ContextMenu) Dispose() {
+	contextMenu.target.RemoveEventHandler(contextMenu.subscription)
+}
+
+func (contextMenu *ContextMenu) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+	x, y := mouseEvent.Position()
+
+	if (mouseEvent.AffectedButtons() == input.MouseSecondary) &&
+		(x >= area.Left().Value()) && (x < area.Right().Value()) &&
+		(y >= area.Top().Value()) && (y < area.Bottom().Value()) {
+		contextMenu.menuBuilder.AtPosition(x, y).Build()
+		consumed = true
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// Dialog is a modal window with a title, a content area and a row of buttons.
+// While it is open, the areas beneath it do not receive any events.
+type Dialog struct {
+	overlays     *area.OverlayManager
+	layer        *area.Area
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+
+	titleLabel *Label
+	content    *area.Area
+	buttons    []*TextButton
+
+	closeHandler ActionHandler
+}
+
+// Content returns the area to which the content of the dialog shall be added.
+func (dialog *Dialog) Content() *area.Area {
+	return dialog.content
+}
+
+// SetTitle sets the text of the title bar.
+func (dialog *Dialog) SetTitle(text string) {
+	dialog.titleLabel.SetText(text)
+}
+
+// Close closes the dialog and releases its resources. The close handler is called.
+func (dialog *Dialog) Close() {
+	dialog.overlays.Close(dialog.layer)
+}
+
+// Dispose closes the dialog.
+func (dialog *Dialog) Dispose() {
+	dialog.Close()
+}
+
+func (dialog *Dialog) onClosed() {
+	for _, button := range dialog.buttons {
+		button.Dispose()
+	}
+	dialog.titleLabel.Dispose()
+	dialog.area.Remove()
+	dialog.closeHandler()
+}
+
+func (dialog *Dialog) onRender(area *area.Area) {
+	left, top, right, bottom := area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value()
+
+	dialog.rectRenderer.Fill(left, top, right, bottom, graphics.RGBA(0.31, 0.56, 0.34, 0.8))
+	dialog.rectRenderer.Fill(left, top, right, top+dialogTitleHeight, graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+}
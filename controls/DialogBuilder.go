@@ -0,0 +1,152 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/layout"
+)
+
+const (
+	dialogTitleHeight  = float32(20.0)
+	dialogButtonHeight = float32(20.0)
+	dialogButtonWidth  = float32(80.0)
+	dialogSpacing      = float32(4.0)
+)
+
+type dialogButton struct {
+	text    string
+	handler ActionHandler
+}
+
+// TextButtonBuilderFactory returns a new builder for every button to create.
+type TextButtonBuilderFactory func() *TextButtonBuilder
+
+// DialogBuilder is a builder for Dialog instances.
+type DialogBuilder struct {
+	labelBuilder  *LabelBuilder
+	buttonFactory TextButtonBuilderFactory
+	rectRenderer  *graphics.RectangleRenderer
+	overlays      *area.OverlayManager
+
+	title   string
+	width   float32
+	height  float32
+	buttons []dialogButton
+
+	closeHandler ActionHandler
+}
+
+// NewDialogBuilder returns a new DialogBuilder instance. Dialogs are opened as modal layers
+// of the given overlay manager.
+func NewDialogBuilder(labelBuilder *LabelBuilder, buttonFactory TextButtonBuilderFactory,
+	rectRenderer *graphics.RectangleRenderer, overlays *area.OverlayManager) *DialogBuilder {
+	builder := &DialogBuilder{
+		labelBuilder:  labelBuilder,
+		buttonFactory: buttonFactory,
+		rectRenderer:  rectRenderer,
+		overlays:      overlays,
+		width:         300.0,
+		height:        200.0,
+		closeHandler:  func() {}}
+
+	return builder
+}
+
+// Build opens a new Dialog instance from the current parameters.
+// The dialog is centered on the root area.
+func (builder *DialogBuilder) Build() *Dialog {
+	dialog := &Dialog{
+		overlays:     builder.overlays,
+		rectRenderer: builder.rectRenderer,
+		closeHandler: builder.closeHandler}
+
+	dialog.layer = builder.overlays.OpenModal(dialog.onClosed)
+
+	left, right := area.NewCenteredAnchors(area.NewRelativeAnchor(dialog.layer.Left(), dialog.layer.Right(), 0.5), builder.width)
+	top, bottom := area.NewCenteredAnchors(area.NewRelativeAnchor(dialog.layer.Top(), dialog.layer.Bottom(), 0.5), builder.height)
+	areaBuilder := area.NewAreaBuilder()
+	areaBuilder.SetParent(dialog.layer)
+	areaBuilder.SetLeft(left)
+	areaBuilder.SetTop(top)
+	areaBuilder.SetRight(right)
+	areaBuilder.SetBottom(bottom)
+	areaBuilder.OnRender(dialog.onRender)
+	dialog.area = areaBuilder.Build()
+
+	builder.labelBuilder.SetParent(dialog.area)
+	builder.labelBuilder.SetLeft(area.NewOffsetAnchor(left, dialogSpacing))
+	builder.labelBuilder.SetTop(top)
+	builder.labelBuilder.SetRight(area.NewOffsetAnchor(right, -dialogSpacing))
+	builder.labelBuilder.SetBottom(area.NewOffsetAnchor(top, dialogTitleHeight))
+	builder.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	dialog.titleLabel = builder.labelBuilder.Build()
+	dialog.titleLabel.SetText(builder.title)
+
+	buttonRowBottom := area.NewOffsetAnchor(bottom, -dialogSpacing)
+	buttonRowTop := area.NewOffsetAnchor(buttonRowBottom, -dialogButtonHeight)
+	contentBuilder := area.NewAreaBuilder()
+	contentBuilder.SetParent(dialog.area)
+	contentBuilder.SetLeft(area.NewOffsetAnchor(left, dialogSpacing))
+	contentBuilder.SetTop(area.NewOffsetAnchor(top, dialogTitleHeight+dialogSpacing))
+	contentBuilder.SetRight(area.NewOffsetAnchor(right, -dialogSpacing))
+	contentBuilder.SetBottom(area.NewOffsetAnchor(buttonRowTop, -dialogSpacing))
+	contentBuilder.SetClipChildren(true)
+	dialog.content = contentBuilder.Build()
+
+	rowBuilder := area.NewAreaBuilder()
+	rowBuilder.SetParent(dialog.area)
+	rowBuilder.SetLeft(area.NewOffsetAnchor(left, dialogSpacing))
+	rowBuilder.SetTop(buttonRowTop)
+	rowBuilder.SetRight(area.NewOffsetAnchor(right, -dialogSpacing))
+	rowBuilder.SetBottom(buttonRowBottom)
+	rowArea := rowBuilder.Build()
+
+	buttonRow := layout.NewHorizontalBox(rowArea)
+	buttonRow.SetSpacing(dialogSpacing)
+	buttonRow.Add(layout.Item{Weight: 1.0})
+	for index, entry := range builder.buttons {
+		handler := entry.handler
+		cell := buttonRow.Add(layout.Item{PreferredWidth: dialogButtonWidth})
+		buttonBuilder := builder.buttonFactory()
+		buttonBuilder.SetParent(rowArea)
+		buttonBuilder.SetLeft(cell.Left())
+		buttonBuilder.SetTop(cell.Top())
+		buttonBuilder.SetRight(cell.Right())
+		buttonBuilder.SetBottom(cell.Bottom())
+		buttonBuilder.SetTabIndex(index)
+		buttonBuilder.WithText(entry.text)
+		buttonBuilder.OnAction(func() {
+			handler()
+			dialog.Close()
+		})
+		dialog.buttons = append(dialog.buttons, buttonBuilder.Build())
+	}
+
+	return dialog
+}
+
+// WithTitle sets the text of the title bar.
+func (builder *DialogBuilder) WithTitle(value string) *DialogBuilder {
+	builder.title = value
+	return builder
+}
+
+// WithSize sets the size of the dialog. Default: 300x200
+func (builder *DialogBuilder) WithSize(width, height float32) *DialogBuilder {
+	builder.width = width
+	builder.height = height
+	return builder
+}
+
+// WithButton appends a button to the button row. Pressing the button calls the handler,
+// and closes the dialog afterwards.
+func (builder *DialogBuilder) WithButton(text string, handler ActionHandler) *DialogBuilder {
+	builder.buttons = append(builder.buttons, dialogButton{text: text, handler: handler})
+	return builder
+}
+
+// OnClose sets the handler that is called after the dialog was closed, for any reason.
+func (builder *DialogBuilder) OnClose(handler ActionHandler) *DialogBuilder {
+	builder.closeHandler = handler
+	return builder
+}
@@ -6,4 +6,16 @@ type Factory interface {
 	ForTextButton() *TextButtonBuilder
 	ForComboBox() *ComboBoxBuilder
 	ForSlider() *SliderBuilder
+	ForTextField() *TextFieldBuilder
+	ForScrollPane() *ScrollPaneBuilder
+	ForDialog() *DialogBuilder
+	ForMenu() *MenuBuilder
+	ForMenuBar() *MenuBarBuilder
+	ForCheckBox() *CheckBoxBuilder
+	ForRadioGroup() *RadioGroupBuilder
+	ForTextArea() *TextAreaBuilder
+	ForListView() *ListViewBuilder
+	ForTable() *TableBuilder
+	ForTreeView() *TreeViewBuilder
+	ForTabPanel() *TabPanelBuilder
 }
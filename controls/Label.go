@@ -46,6 +46,12 @@ func (label *Label) SetText(text string) {
 	label.texture = label.texturizer(&label.bitmap.Bitmap)
 }
 
+// TextSize returns the size of the current text, as rendered with the scale of the label.
+func (label *Label) TextSize() (width, height float32) {
+	textWidth, textHeight := label.texture.Size()
+	return textWidth * label.scale, textHeight * label.scale
+}
+
 func (label *Label) onRender(area *area.Area) {
 	u, v := label.texture.UV()
 	fromLeft := float32(0.0)
@@ -50,6 +50,12 @@ func (builder *LabelBuilder) Build() *Label {
 	return label
 }
 
+// measure returns the size of given text, as it would be rendered by a label from this builder.
+func (builder *LabelBuilder) measure(text string) (width, height float32) {
+	bmp := builder.textPainter.Paint(text)
+	return float32(bmp.Width) * builder.scale, float32(bmp.Height) * builder.scale
+}
+
 // SetParent sets the parent area.
 func (builder *LabelBuilder) SetParent(parent *area.Area) *LabelBuilder {
 	builder.areaBuilder.SetParent(parent)
@@ -80,6 +86,12 @@ func (builder *LabelBuilder) SetBottom(value area.Anchor) *LabelBuilder {
 	return builder
 }
 
+// SetTooltip sets the explanatory text shown while the cursor rests on the label. Default: ""
+func (builder *LabelBuilder) SetTooltip(text string) *LabelBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
 // SetScale sets the scaling factor of the text. Default: 1.0
 func (builder *LabelBuilder) SetScale(value float32) *LabelBuilder {
 	builder.scale = value
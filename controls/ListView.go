@@ -0,0 +1,320 @@
+package controls
+
+import (
+	"sort"
+
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// ListDataSource provides the items shown in a list view.
+// A list view only requests the items that are currently visible.
+type ListDataSource interface {
+	// ItemCount returns the number of available items.
+	ItemCount() int
+	// RenderItem sets up the given label to display the item at given index.
+	RenderItem(index int, label *Label)
+}
+
+// StringListDataSource is a list data source for a fixed list of texts.
+type StringListDataSource []string
+
+// ItemCount implements the ListDataSource interface.
+func (source StringListDataSource) ItemCount() int {
+	return len(source)
+}
+
+// RenderItem implements the ListDataSource interface.
+func (source StringListDataSource) RenderItem(index int, label *Label) {
+	label.SetText(source[index])
+}
+
+// ListSelectionChangeHandler is a callback for notifying the indices of the currently selected items.
+type ListSelectionChangeHandler func(selected []int)
+
+// ListView is a control for selecting items from a scrollable list of arbitrary length.
+// Only the visible rows are rendered, which are queried from a data source.
+type ListView struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+	scroller     *rowScroller
+
+	dataSource     ListDataSource
+	rowHeight      float32
+	multiSelection bool
+
+	listSelectionChangeHandler ListSelectionChangeHandler
+
+	rowLabels   []*Label
+	cursorIndex int
+	anchorIndex int
+	selected    map[int]bool
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (list *ListView) Dispose() {
+	list.scroller.dispose()
+	list.area.Remove()
+}
+
+// Refresh queries the data source again. It is to be called after the items have changed.
+// Selected indices beyond the new item count are dropped. Does not fire change handler.
+func (list *ListView) Refresh() {
+	count := list.dataSource.ItemCount()
+
+	for index := range list.selected {
+		if index >= count {
+			delete(list.selected, index)
+		}
+	}
+	list.cursorIndex = list.limitedIndex(list.cursorIndex)
+	list.anchorIndex = list.limitedIndex(list.anchorIndex)
+	list.scroller.scrollTo(list.scroller.startIndex)
+	list.updateRowLabels()
+}
+
+// SelectedIndices returns the indices of the currently selected items, in ascending order.
+func (list *ListView) SelectedIndices() []int {
+	indices := make([]int, 0, len(list.selected))
+
+	for index := range list.selected {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+
+	return indices
+}
+
+// SetSelectedIndices changes which items are currently selected. Does not fire change handler.
+// Without multi-selection, only the first given index is selected.
+func (list *ListView) SetSelectedIndices(indices []int) {
+	count := list.dataSource.ItemCount()
+
+	list.selected = make(map[int]bool)
+	for _, index := range indices {
+		if (index >= 0) && (index < count) && (list.multiSelection || (len(list.selected) == 0)) {
+			list.selected[index] = true
+			if len(list.selected) == 1 {
+				list.cursorIndex = index
+				list.anchorIndex = index
+			}
+		}
+	}
+}
+
+// ScrollToIndex scrolls the list the least amount necessary for the given item to be visible.
+func (list *ListView) ScrollToIndex(index int) {
+	list.scroller.scrollToIndex(index)
+}
+
+func (list *ListView) onRender(area *area.Area) {
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value() - scrollBarThickness
+	areaBottom := area.Bottom().Value()
+	count := list.dataSource.ItemCount()
+
+	list.rectRenderer.Fill(areaLeft, areaTop, area.Right().Value(), areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.5))
+	for row := range list.rowLabels {
+		index := list.scroller.startIndex + row
+		rowTop := areaTop + float32(row)*list.rowHeight
+		rowBottom := rowTop + list.rowHeight
+		if rowBottom > areaBottom {
+			rowBottom = areaBottom
+		}
+		if (index < count) && list.selected[index] {
+			list.rectRenderer.Fill(areaLeft, rowTop, areaRight, rowBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+		}
+		if (index == list.cursorIndex) && (index < count) && area.HasFocus() {
+			renderOutline(list.rectRenderer, areaLeft, rowTop, areaRight, rowBottom, graphics.RGBA(0.5, 0.58, 0.33, 1.0))
+		}
+	}
+}
+
+func (list *ListView) onBoundsChange(area *area.Area) {
+	list.scroller.onBoundsChange()
+}
+
+func (list *ListView) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !list.area.Contains(mouseEvent.Position()) {
+		if area.HasFocus() {
+			area.ReleaseFocus()
+		}
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		_, mouseY := mouseEvent.Position()
+		modifier := input.Modifier(mouseEvent.Modifier())
+		index := list.scroller.indexAt(mouseY)
+
+		area.RequestFocus()
+		if index < list.dataSource.ItemCount() {
+			list.selectByUser(index, modifier.Has(input.ModShift), modifier.Has(input.ModControl))
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (list *ListView) onMouseScroll(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseScrollEvent)
+
+	if list.area.Contains(mouseEvent.Position()) {
+		_, dy := mouseEvent.Deltas()
+		list.scroller.scrollByWheel(dy)
+		consumed = true
+	}
+
+	return
+}
+
+func (list *ListView) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+	extend := keyEvent.Modifier().Has(input.ModShift)
+	toggle := keyEvent.Modifier().Has(input.ModControl)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyUp:
+		list.moveCursor(list.cursorIndex-1, extend, toggle)
+	case input.KeyDown:
+		list.moveCursor(list.cursorIndex+1, extend, toggle)
+	case input.KeyPageUp:
+		list.moveCursor(list.cursorIndex-list.scroller.fullRowCount(), extend, toggle)
+	case input.KeyPageDown:
+		list.moveCursor(list.cursorIndex+list.scroller.fullRowCount(), extend, toggle)
+	case input.KeyHome:
+		list.moveCursor(0, extend, toggle)
+	case input.KeyEnd:
+		list.moveCursor(list.dataSource.ItemCount()-1, extend, toggle)
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (list *ListView) onChar(area *area.Area, event events.Event) (consumed bool) {
+	charEvent := event.(*events.CharEvent)
+
+	if (charEvent.Char() == ' ') && (list.dataSource.ItemCount() > 0) {
+		list.selectByUser(list.cursorIndex, false, true)
+		consumed = true
+	}
+
+	return
+}
+
+func (list *ListView) limitedIndex(index int) int {
+	count := list.dataSource.ItemCount()
+
+	if index >= count {
+		index = count - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	return index
+}
+
+// moveCursor moves the cursor to the given item. With multi-selection and toggle set,
+// the selection is kept as is. Otherwise, the item becomes selected, or the range up to it if extending.
+func (list *ListView) moveCursor(index int, extend, toggle bool) {
+	if list.dataSource.ItemCount() > 0 {
+		index = list.limitedIndex(index)
+		if list.multiSelection && toggle && !extend {
+			list.cursorIndex = index
+			list.ScrollToIndex(index)
+		} else {
+			list.selectByUser(index, extend, false)
+		}
+	}
+}
+
+// selectByUser changes the selection in response to user input and notifies any change.
+// Extending selects the range from the anchor to the given item, toggling flips the given item.
+// Without multi-selection, only the given item is selected.
+func (list *ListView) selectByUser(index int, extend, toggle bool) {
+	previous := list.SelectedIndices()
+
+	if !list.multiSelection {
+		list.selected = map[int]bool{index: true}
+		list.anchorIndex = index
+	} else if extend {
+		from, to := list.anchorIndex, index
+		if from > to {
+			from, to = to, from
+		}
+		list.selected = make(map[int]bool)
+		for rangeIndex := from; rangeIndex <= to; rangeIndex++ {
+			list.selected[rangeIndex] = true
+		}
+	} else if toggle {
+		if list.selected[index] {
+			delete(list.selected, index)
+		} else {
+			list.selected[index] = true
+		}
+		list.anchorIndex = index
+	} else {
+		list.selected = map[int]bool{index: true}
+		list.anchorIndex = index
+	}
+	list.cursorIndex = index
+	list.ScrollToIndex(index)
+
+	current := list.SelectedIndices()
+	if !equalIndices(previous, current) {
+		list.listSelectionChangeHandler(current)
+	}
+}
+
+func (list *ListView) rowCount() int {
+	return list.dataSource.ItemCount()
+}
+
+func (list *ListView) appendRowLabels(top area.Anchor) {
+	list.labelBuilder.SetParent(list.area)
+	list.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	list.labelBuilder.SetLeft(area.NewOffsetAnchor(list.area.Left(), 4))
+	list.labelBuilder.SetRight(area.NewOffsetAnchor(list.area.Right(), -scrollBarThickness-4))
+	list.labelBuilder.SetTop(top)
+	list.labelBuilder.SetBottom(area.NewOffsetAnchor(top, list.rowHeight))
+	list.rowLabels = append(list.rowLabels, list.labelBuilder.Build())
+}
+
+func (list *ListView) disposeRowLabels() {
+	for _, label := range list.rowLabels {
+		label.Dispose()
+	}
+	list.rowLabels = nil
+}
+
+func (list *ListView) updateRowLabels() {
+	count := list.dataSource.ItemCount()
+
+	for row, label := range list.rowLabels {
+		index := list.scroller.startIndex + row
+		if index < count {
+			list.dataSource.RenderItem(index, label)
+		} else {
+			label.SetText("")
+		}
+	}
+}
+
+func equalIndices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for position, index := range a {
+		if b[position] != index {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// ListViewBuilder is a builder for ListView instances.
+type ListViewBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	dataSource     ListDataSource
+	rowHeight      float32
+	multiSelection bool
+
+	listSelectionChangeHandler ListSelectionChangeHandler
+}
+
+// NewListViewBuilder returns a new ListViewBuilder instance.
+func NewListViewBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer) *ListViewBuilder {
+	builder := &ListViewBuilder{
+		areaBuilder:                area.NewAreaBuilder(),
+		rectRenderer:               rectRenderer,
+		labelBuilder:               labelBuilder,
+		dataSource:                 StringListDataSource(nil),
+		rowHeight:                  20,
+		listSelectionChangeHandler: func([]int) {}}
+
+	return builder
+}
+
+// Build creates a new ListView instance from the current parameters.
+func (builder *ListViewBuilder) Build() *ListView {
+	list := &ListView{
+		rectRenderer:               builder.rectRenderer,
+		labelBuilder:               builder.labelBuilder,
+		dataSource:                 builder.dataSource,
+		rowHeight:                  builder.rowHeight,
+		multiSelection:             builder.multiSelection,
+		listSelectionChangeHandler: builder.listSelectionChangeHandler,
+		selected:                   make(map[int]bool)}
+
+	builder.areaBuilder.SetClipChildren(true)
+	builder.areaBuilder.OnRender(list.onRender)
+	builder.areaBuilder.OnBoundsChange(list.onBoundsChange)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, list.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseScrollEventType, list.onMouseScroll)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, list.onKey)
+	builder.areaBuilder.OnEvent(events.CharEventType, list.onChar)
+	builder.areaBuilder.SetFocusable(true)
+	list.area = builder.areaBuilder.Build()
+	list.scroller = newRowScroller(list.area, builder.rectRenderer, list, list.rowHeight, 0)
+
+	return list
+}
+
+// SetParent sets the parent area.
+func (builder *ListViewBuilder) SetParent(parent *area.Area) *ListViewBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *ListViewBuilder) SetLeft(value area.Anchor) *ListViewBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *ListViewBuilder) SetTop(value area.Anchor) *ListViewBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *ListViewBuilder) SetRight(value area.Anchor) *ListViewBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *ListViewBuilder) SetBottom(value area.Anchor) *ListViewBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *ListViewBuilder) SetTabIndex(value int) *ListViewBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the list. Default: ""
+func (builder *ListViewBuilder) SetTooltip(text string) *ListViewBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithDataSource sets the source of the items. Default: an empty list
+func (builder *ListViewBuilder) WithDataSource(dataSource ListDataSource) *ListViewBuilder {
+	builder.dataSource = dataSource
+	return builder
+}
+
+// WithRowHeight sets the height of each row. Default: 20
+func (builder *ListViewBuilder) WithRowHeight(value float32) *ListViewBuilder {
+	builder.rowHeight = value
+	return builder
+}
+
+// WithMultiSelection sets whether several items can be selected at once,
+// by holding Shift for a range, or Control for single items. Default: false
+func (builder *ListViewBuilder) WithMultiSelection(value bool) *ListViewBuilder {
+	builder.multiSelection = value
+	return builder
+}
+
+// WithListSelectionChangeHandler sets the handler for a change of the selection.
+func (builder *ListViewBuilder) WithListSelectionChangeHandler(handler ListSelectionChangeHandler) *ListViewBuilder {
+	builder.listSelectionChangeHandler = handler
+	return builder
+}
@@ -0,0 +1,375 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+const (
+	menuItemHeight      = float32(20.0)
+	menuSeparatorHeight = float32(7.0)
+	menuPadding         = float32(4.0)
+	menuCheckWidth      = float32(12.0)
+	menuShortcutSpacing = float32(16.0)
+	menuMinimumWidth    = float32(80.0)
+)
+
+// menuPlacement describes where a menu panel shall be placed. The panel starts at left and top,
+// unless it does not fit into the root area. It then ends at right, or bottom respectively.
+type menuPlacement struct {
+	left, top, right, bottom float32
+}
+
+// Menu is a popup list of items, with optional nested submenus.
+// It is closed when an item is activated, the escape key is pressed, or a mouse button
+// is pressed outside of it.
+type Menu struct {
+	overlays     *area.OverlayManager
+	labelBuilder *LabelBuilder
+	rectRenderer *graphics.RectangleRenderer
+
+	layer *area.Area
+	panel *menuPanel
+
+	closeHandler      ActionHandler
+	navigationHandler func(direction int)
+}
+
+// Close closes the menu and all of its submenus. The close handler is called.
+func (menu *Menu) Close() {
+	menu.overlays.Close(menu.layer)
+}
+
+// Dispose closes the menu.
+func (menu *Menu) Dispose() {
+	menu.Close()
+}
+
+func (menu *Menu) onClosed() {
+	menu.panel.dispose()
+	menu.closeHandler()
+}
+
+func (menu *Menu) activate(item *MenuItem) {
+	if item.Checkable {
+		item.Checked = !item.Checked
+	}
+	menu.Close()
+	if item.Action != nil {
+		item.Action()
+	}
+}
+
+type menuPanel struct {
+	menu   *Menu
+	parent *menuPanel
+	items  []*MenuItem
+
+	area           *area.Area
+	rowTops        []float32
+	labels         []*Label
+	highlightIndex int
+	pressed        bool
+
+	submenu *menuPanel
+}
+
+func newMenuPanel(menu *Menu, parent *menuPanel, items []*MenuItem, placement menuPlacement) *menuPanel {
+	panel := &menuPanel{menu: menu, parent: parent, items: items, highlightIndex: -1}
+	left := area.NewAbsoluteAnchor(0.0)
+	top := area.NewAbsoluteAnchor(0.0)
+	right := area.NewAbsoluteAnchor(0.0)
+	bottom := area.NewAbsoluteAnchor(0.0)
+	areaBuilder := area.NewAreaBuilder()
+
+	areaBuilder.SetParent(menu.layer)
+	areaBuilder.SetLeft(left)
+	areaBuilder.SetTop(top)
+	areaBuilder.SetRight(right)
+	areaBuilder.SetBottom(bottom)
+	areaBuilder.OnRender(panel.onRender)
+	areaBuilder.OnEvent(events.MouseMoveEventType, panel.onMouseMove)
+	areaBuilder.OnEvent(events.MouseButtonDownEventType, panel.onMouseDown)
+	areaBuilder.OnEvent(events.MouseButtonUpEventType, panel.onMouseUp)
+	areaBuilder.OnEvent(events.KeyEventType, panel.onKey)
+	panel.area = areaBuilder.Build()
+
+	textWidth := float32(0.0)
+	shortcutWidth := float32(0.0)
+	height := menuPadding
+	for _, item := range items {
+		panel.rowTops = append(panel.rowTops, height)
+		if item.Separator {
+			height += menuSeparatorHeight
+			continue
+		}
+		shortcut := item.Shortcut
+		if len(item.Submenu) > 0 {
+			shortcut = ">"
+		}
+		itemWidth := panel.addLabel(item.Text, LeftAligner, left, top, right, height)
+		if itemWidth > textWidth {
+			textWidth = itemWidth
+		}
+		if shortcut != "" {
+			itemWidth = panel.addLabel(shortcut, RightAligner, left, top, right, height)
+			if itemWidth > shortcutWidth {
+				shortcutWidth = itemWidth
+			}
+		}
+		if item.Disabled {
+			panel.addDisabledCover(left, top, right, height)
+		}
+		height += menuItemHeight
+	}
+	height += menuPadding
+
+	width := menuPadding + menuCheckWidth + textWidth + menuPadding
+	if shortcutWidth > 0 {
+		width += menuShortcutSpacing + shortcutWidth
+	}
+	if width < menuMinimumWidth {
+		width = menuMinimumWidth
+	}
+	panelLeft, panelTop := panel.place(placement, width, height)
+	left.RequestValue(panelLeft)
+	top.RequestValue(panelTop)
+	right.RequestValue(panelLeft + width)
+	bottom.RequestValue(panelTop + height)
+	panel.area.RequestFocus()
+
+	return panel
+}
+
+func (panel *menuPanel) addLabel(text string, aligner Aligner, left, top, right area.Anchor, rowTop float32) float32 {
+	labelBuilder := panel.menu.labelBuilder
+
+	labelBuilder.SetParent(panel.area)
+	labelBuilder.SetLeft(area.NewOffsetAnchor(left, menuPadding+menuCheckWidth))
+	labelBuilder.SetTop(area.NewOffsetAnchor(top, rowTop))
+	labelBuilder.SetRight(area.NewOffsetAnchor(right, -menuPadding))
+	labelBuilder.SetBottom(area.NewOffsetAnchor(top, rowTop+menuItemHeight))
+	labelBuilder.AlignedHorizontallyBy(aligner)
+	label := labelBuilder.Build()
+	label.SetText(text)
+	panel.labels = append(panel.labels, label)
+	width, _ := label.TextSize()
+
+	return width
+}
+
+func (panel *menuPanel) addDisabledCover(left, top, right area.Anchor, rowTop float32) {
+	coverBuilder := area.NewAreaBuilder()
+
+	coverBuilder.SetParent(panel.area)
+	coverBuilder.SetLeft(left)
+	coverBuilder.SetTop(area.NewOffsetAnchor(top, rowTop))
+	coverBuilder.SetRight(right)
+	coverBuilder.SetBottom(area.NewOffsetAnchor(top, rowTop+menuItemHeight))
+	coverBuilder.SetZIndex(1)
+	coverBuilder.OnRender(func(area *area.Area) {
+		panel.menu.rectRenderer.Fill(area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value(),
+			graphics.RGBA(0.31, 0.56, 0.34, 0.6))
+	})
+	coverBuilder.Build()
+}
+
+func (panel *menuPanel) place(placement menuPlacement, width, height float32) (left, top float32) {
+	bounds := panel.menu.layer
+	rootLeft, rootTop := bounds.Left().Value(), bounds.Top().Value()
+	rootRight, rootBottom := bounds.Right().Value(), bounds.Bottom().Value()
+
+	left = placement.left
+	if ((left + width) > rootRight) && ((placement.right - width) >= rootLeft) {
+		left = placement.right - width
+	} else if (left + width) > rootRight {
+		left = rootRight - width
+	}
+	if left < rootLeft {
+		left = rootLeft
+	}
+	top = placement.top
+	if ((top + height) > rootBottom) && ((placement.bottom - height) >= rootTop) {
+		top = placement.bottom - height
+	} else if (top + height) > rootBottom {
+		top = rootBottom - height
+	}
+	if top < rootTop {
+		top = rootTop
+	}
+
+	return
+}
+
+func (panel *menuPanel) dispose() {
+	panel.closeSubmenu()
+	for _, label := range panel.labels {
+		label.Dispose()
+	}
+	panel.labels = nil
+	panel.area.Remove()
+}
+
+func (panel *menuPanel) rowBounds(index int) (top, bottom float32) {
+	top = panel.area.Top().Value() + panel.rowTops[index]
+	bottom = top + menuItemHeight
+	if panel.items[index].Separator {
+		bottom = top + menuSeparatorHeight
+	}
+	return
+}
+
+func (panel *menuPanel) onRender(area *area.Area) {
+	renderer := panel.menu.rectRenderer
+	areaLeft, areaRight := area.Left().Value(), area.Right().Value()
+
+	renderer.Fill(areaLeft, area.Top().Value(), areaRight, area.Bottom().Value(), graphics.RGBA(0.31, 0.56, 0.34, 0.9))
+	for index, item := range panel.items {
+		rowTop, rowBottom := panel.rowBounds(index)
+
+		if item.Separator {
+			lineTop := rowTop + menuSeparatorHeight/2
+			renderer.Fill(areaLeft+menuPadding, lineTop, areaRight-menuPadding, lineTop+1, graphics.RGBA(0.5, 0.58, 0.33, 1.0))
+			continue
+		}
+		if index == panel.highlightIndex {
+			renderer.Fill(areaLeft, rowTop, areaRight, rowBottom, graphics.RGBA(0.36, 0.62, 0.39, 0.95))
+		}
+		if item.Checkable && item.Checked {
+			markLeft := areaLeft + menuPadding + 2
+			markTop := rowTop + (menuItemHeight-menuCheckWidth)/2 + 2
+			renderer.Fill(markLeft, markTop, markLeft+menuCheckWidth-4, markTop+menuCheckWidth-4, graphics.RGBA(0.5, 0.58, 0.33, 1.0))
+		}
+	}
+}
+
+func (panel *menuPanel) indexAt(event events.PositionalEvent) int {
+	_, y := event.Position()
+
+	for index := range panel.items {
+		rowTop, rowBottom := panel.rowBounds(index)
+		if (y >= rowTop) && (y < rowBottom) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (panel *menuPanel) onMouseMove(area *area.Area, event events.Event) (consumed bool) {
+	moveEvent := event.(*events.MouseMoveEvent)
+
+	if panel.area.Contains(moveEvent.Position()) {
+		index := panel.indexAt(moveEvent)
+		if (index >= 0) && panel.items[index].isSelectable() && (index != panel.highlightIndex) {
+			panel.highlight(index)
+			panel.openSubmenu(false)
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (panel *menuPanel) onMouseDown(area *area.Area, event events.Event) bool {
+	panel.pressed = panel.area.Contains(event.(*events.MouseButtonEvent).Position())
+	return panel.pressed
+}
+
+func (panel *menuPanel) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if panel.area.Contains(mouseEvent.Position()) {
+		index := panel.indexAt(mouseEvent)
+		if panel.pressed && (index >= 0) && panel.items[index].isActivatable() {
+			panel.menu.activate(panel.items[index])
+		}
+		consumed = true
+	}
+	panel.pressed = false
+
+	return
+}
+
+func (panel *menuPanel) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyUp:
+		panel.moveHighlight(-1)
+	case input.KeyDown:
+		panel.moveHighlight(1)
+	case input.KeyRight:
+		panel.openSubmenu(true)
+		if panel.submenu == nil {
+			panel.menu.navigationHandler(1)
+		}
+	case input.KeyLeft:
+		if panel.parent != nil {
+			panel.parent.closeSubmenu()
+			panel.parent.area.RequestFocus()
+		} else {
+			panel.menu.navigationHandler(-1)
+		}
+	case input.KeyEnter:
+		if (panel.highlightIndex >= 0) && panel.items[panel.highlightIndex].isActivatable() {
+			panel.menu.activate(panel.items[panel.highlightIndex])
+		} else {
+			panel.openSubmenu(true)
+		}
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (panel *menuPanel) moveHighlight(delta int) {
+	count := len(panel.items)
+	index := panel.highlightIndex
+
+	if (index < 0) && (delta < 0) {
+		index = count
+	}
+	for step := 0; step < count; step++ {
+		index = (index + delta + count) % count
+		if panel.items[index].isSelectable() {
+			panel.highlight(index)
+			return
+		}
+	}
+}
+
+func (panel *menuPanel) highlight(index int) {
+	panel.closeSubmenu()
+	panel.highlightIndex = index
+}
+
+func (panel *menuPanel) openSubmenu(focus bool) {
+	if (panel.submenu == nil) && (panel.highlightIndex >= 0) {
+		item := panel.items[panel.highlightIndex]
+
+		if !item.Disabled && (len(item.Submenu) > 0) {
+			rowTop, rowBottom := panel.rowBounds(panel.highlightIndex)
+			panel.submenu = newMenuPanel(panel.menu, panel, item.Submenu, menuPlacement{
+				left:   panel.area.Right().Value(),
+				top:    rowTop - menuPadding,
+				right:  panel.area.Left().Value(),
+				bottom: rowBottom + menuPadding})
+			if focus {
+				panel.submenu.moveHighlight(1)
+			} else {
+				panel.area.RequestFocus()
+			}
+		}
+	}
+}
+
+func (panel *menuPanel) closeSubmenu() {
+	if panel.submenu != nil {
+		panel.submenu.dispose()
+		panel.submenu = nil
+	}
+}
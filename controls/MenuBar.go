@@ -0,0 +1,172 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+const menuBarTitlePadding = float32(8.0)
+
+type menuBarEntry struct {
+	area  *area.Area
+	label *Label
+	items []*MenuItem
+}
+
+// MenuBar is a horizontal bar of menu titles. Pressing a title opens its menu below the bar.
+// While a menu is open, pressing another title or the left and right keys switch to that menu.
+type MenuBar struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	menuBuilder  *MenuBuilder
+
+	entries        []*menuBarEntry
+	highlightIndex int
+	openIndex      int
+	openMenu       *Menu
+}
+
+// Dispose closes an open menu and releases all resources.
+func (bar *MenuBar) Dispose() {
+	if bar.openMenu != nil {
+		bar.openMenu.Close()
+	}
+	for _, entry := range bar.entries {
+		entry.label.Dispose()
+	}
+	bar.area.Remove()
+}
+
+// OpenMenu opens the menu at given index. A currently open menu is closed.
+func (bar *MenuBar) OpenMenu(index int) {
+	if (index >= 0) && (index < len(bar.entries)) {
+		entry := bar.entries[index]
+
+		if bar.openMenu != nil {
+			bar.openMenu.Close()
+		}
+		bar.highlightIndex = index
+		bar.openIndex = index
+		bar.menuBuilder.WithItems(entry.items)
+		bar.menuBuilder.placement = menuPlacement{
+			left:   entry.area.Left().Value(),
+			top:    bar.area.Bottom().Value(),
+			right:  entry.area.Right().Value(),
+			bottom: bar.area.Top().Value()}
+		bar.openMenu = bar.menuBuilder.Build()
+		bar.openMenu.layer.AddEventHandler(events.MouseButtonDownEventType, func(layer *area.Area, event events.Event) bool {
+			return bar.onMenuLayerMouseDown(index, event)
+		})
+	}
+}
+
+func (bar *MenuBar) onRender(area *area.Area) {
+	bar.rectRenderer.Fill(area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value(),
+		graphics.RGBA(0.31, 0.56, 0.34, 0.8))
+	if bar.highlightIndex >= 0 {
+		entry := bar.entries[bar.highlightIndex].area
+		bar.rectRenderer.Fill(entry.Left().Value(), entry.Top().Value(), entry.Right().Value(), entry.Bottom().Value(),
+			graphics.RGBA(0.36, 0.62, 0.39, 0.85))
+	}
+}
+
+func (bar *MenuBar) onMenuClosed() {
+	bar.openIndex = -1
+	bar.openMenu = nil
+	bar.highlightIndex = -1
+}
+
+func (bar *MenuBar) onMenuNavigation(direction int) {
+	if bar.openIndex >= 0 {
+		count := len(bar.entries)
+		bar.OpenMenu((bar.openIndex + direction + count) % count)
+	}
+}
+
+// onMenuLayerMouseDown is called for presses outside the menu opened at given index.
+// The layer has already closed the menu at this point; pressing another title opens its menu right away.
+func (bar *MenuBar) onMenuLayerMouseDown(openIndex int, event events.Event) bool {
+	mouseEvent := event.(*events.MouseButtonEvent)
+	index := bar.entryAt(mouseEvent)
+
+	if (mouseEvent.AffectedButtons() == input.MousePrimary) && (index >= 0) && (index != openIndex) {
+		bar.OpenMenu(index)
+	}
+	return true
+}
+
+func (bar *MenuBar) entryAt(event events.PositionalEvent) int {
+	x, y := event.Position()
+
+	for index, entry := range bar.entries {
+		if entry.area.Contains(x, y) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (bar *MenuBar) onMouseMove(area *area.Area, event events.Event) bool {
+	bar.highlightIndex = bar.entryAt(event.(*events.MouseMoveEvent))
+	return true
+}
+
+func (bar *MenuBar) onMouseLeave(area *area.Area, event events.Event) bool {
+	if !area.HasFocus() {
+		bar.highlightIndex = -1
+	}
+	return true
+}
+
+func (bar *MenuBar) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+	index := bar.entryAt(mouseEvent)
+
+	if mouseEvent.AffectedButtons() == input.MousePrimary {
+		if index >= 0 {
+			area.RequestFocus()
+			bar.OpenMenu(index)
+			consumed = true
+		} else {
+			area.ReleaseFocus()
+		}
+	}
+
+	return
+}
+
+func (bar *MenuBar) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+	count := len(bar.entries)
+
+	if count > 0 {
+		consumed = true
+		switch keyEvent.Key() {
+		case input.KeyLeft:
+			bar.moveHighlight(-1)
+		case input.KeyRight:
+			bar.moveHighlight(1)
+		case input.KeyDown, input.KeyEnter:
+			if bar.highlightIndex < 0 {
+				bar.highlightIndex = 0
+			}
+			bar.OpenMenu(bar.highlightIndex)
+		default:
+			consumed = false
+		}
+	}
+
+	return
+}
+
+func (bar *MenuBar) moveHighlight(delta int) {
+	count := len(bar.entries)
+
+	if bar.highlightIndex < 0 {
+		bar.highlightIndex = 0
+	} else {
+		bar.highlightIndex = (bar.highlightIndex + delta + count) % count
+	}
+}
@@ -0,0 +1,130 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+type menuBarMenu struct {
+	title string
+	items []*MenuItem
+}
+
+// MenuBarBuilder is a builder for MenuBar instances.
+type MenuBarBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	labelBuilder *LabelBuilder
+	rectRenderer *graphics.RectangleRenderer
+	overlays     *area.OverlayManager
+
+	menus []menuBarMenu
+}
+
+// NewMenuBarBuilder returns a new MenuBarBuilder instance. The menus are opened as popup layers
+// of the given overlay manager.
+func NewMenuBarBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer,
+	overlays *area.OverlayManager) *MenuBarBuilder {
+	builder := &MenuBarBuilder{
+		areaBuilder:  area.NewAreaBuilder(),
+		labelBuilder: labelBuilder,
+		rectRenderer: rectRenderer,
+		overlays:     overlays}
+
+	return builder
+}
+
+// Build creates a new MenuBar instance from the current parameters.
+func (builder *MenuBarBuilder) Build() *MenuBar {
+	bar := &MenuBar{
+		rectRenderer:   builder.rectRenderer,
+		highlightIndex: -1,
+		openIndex:      -1}
+
+	bar.menuBuilder = NewMenuBuilder(builder.labelBuilder, builder.rectRenderer, builder.overlays)
+	bar.menuBuilder.OnClose(bar.onMenuClosed)
+	bar.menuBuilder.navigationHandler = bar.onMenuNavigation
+
+	builder.areaBuilder.OnRender(bar.onRender)
+	builder.areaBuilder.OnEvent(events.MouseMoveEventType, bar.onMouseMove)
+	builder.areaBuilder.OnEvent(events.MouseLeaveEventType, bar.onMouseLeave)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, bar.onMouseDown)
+	builder.areaBuilder.OnEvent(events.KeyEventType, bar.onKey)
+	builder.areaBuilder.SetFocusable(true)
+	bar.area = builder.areaBuilder.Build()
+
+	lastRight := bar.area.Left()
+	for _, menu := range builder.menus {
+		textWidth, _ := builder.labelBuilder.measure(menu.title)
+		entry := &menuBarEntry{items: menu.items}
+		entryBuilder := area.NewAreaBuilder()
+
+		entryBuilder.SetParent(bar.area)
+		entryBuilder.SetLeft(lastRight)
+		entryBuilder.SetTop(bar.area.Top())
+		entryBuilder.SetRight(area.NewOffsetAnchor(lastRight, textWidth+2*menuBarTitlePadding))
+		entryBuilder.SetBottom(bar.area.Bottom())
+		entry.area = entryBuilder.Build()
+		lastRight = entry.area.Right()
+
+		builder.labelBuilder.SetParent(entry.area)
+		builder.labelBuilder.SetLeft(entry.area.Left())
+		builder.labelBuilder.SetTop(entry.area.Top())
+		builder.labelBuilder.SetRight(entry.area.Right())
+		builder.labelBuilder.SetBottom(entry.area.Bottom())
+		entry.label = builder.labelBuilder.Build()
+		entry.label.SetText(menu.title)
+
+		bar.entries = append(bar.entries, entry)
+	}
+
+	return bar
+}
+
+// SetParent sets the parent area.
+func (builder *MenuBarBuilder) SetParent(parent *area.Area) *MenuBarBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *MenuBarBuilder) SetLeft(value area.Anchor) *MenuBarBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *MenuBarBuilder) SetTop(value area.Anchor) *MenuBarBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *MenuBarBuilder) SetRight(value area.Anchor) *MenuBarBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *MenuBarBuilder) SetBottom(value area.Anchor) *MenuBarBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *MenuBarBuilder) SetTabIndex(value int) *MenuBarBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the menu bar. Default: ""
+func (builder *MenuBarBuilder) SetTooltip(text string) *MenuBarBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithMenu appends a menu with given title and items to the bar.
+func (builder *MenuBarBuilder) WithMenu(title string, items []*MenuItem) *MenuBarBuilder {
+	builder.menus = append(builder.menus, menuBarMenu{title: title, items: items})
+	return builder
+}
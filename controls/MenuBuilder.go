@@ -0,0 +1,68 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// MenuBuilder is a builder for Menu instances.
+type MenuBuilder struct {
+	labelBuilder *LabelBuilder
+	rectRenderer *graphics.RectangleRenderer
+	overlays     *area.OverlayManager
+
+	items     []*MenuItem
+	placement menuPlacement
+
+	closeHandler ActionHandler
+	// navigationHandler is called with -1 or 1 when the left or right key is not used within the menu.
+	navigationHandler func(direction int)
+}
+
+// NewMenuBuilder returns a new MenuBuilder instance. Menus are opened as popup layers
+// of the given overlay manager.
+func NewMenuBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer,
+	overlays *area.OverlayManager) *MenuBuilder {
+	builder := &MenuBuilder{
+		labelBuilder:      labelBuilder,
+		rectRenderer:      rectRenderer,
+		overlays:          overlays,
+		closeHandler:      func() {},
+		navigationHandler: func(int) {}}
+
+	return builder
+}
+
+// Build opens a new Menu instance from the current parameters.
+func (builder *MenuBuilder) Build() *Menu {
+	menu := &Menu{
+		overlays:          builder.overlays,
+		labelBuilder:      builder.labelBuilder,
+		rectRenderer:      builder.rectRenderer,
+		closeHandler:      builder.closeHandler,
+		navigationHandler: builder.navigationHandler}
+
+	menu.layer = builder.overlays.OpenPopup(menu.onClosed)
+	menu.panel = newMenuPanel(menu, nil, builder.items, builder.placement)
+
+	return menu
+}
+
+// WithItems sets the items of the menu.
+func (builder *MenuBuilder) WithItems(items []*MenuItem) *MenuBuilder {
+	builder.items = items
+	return builder
+}
+
+// AtPosition sets the top left corner of the menu. If the menu does not fit into the root area,
+// it is placed to the left of, or above, the position instead. Default: 0, 0
+func (builder *MenuBuilder) AtPosition(x, y float32) *MenuBuilder {
+	builder.placement = menuPlacement{left: x, top: y, right: x, bottom: y}
+	return builder
+}
+
+// OnClose sets the handler that is called after the menu was closed, for any reason.
+func (builder *MenuBuilder) OnClose(handler ActionHandler) *MenuBuilder {
+	builder.closeHandler = handler
+	return builder
+}
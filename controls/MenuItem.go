@@ -0,0 +1,34 @@
+package controls
+
+// MenuItem describes one entry of a menu.
+type MenuItem struct {
+	// Text is the displayed name of the item.
+	Text string
+	// Shortcut is the displayed key combination of the item. It is for information only.
+	Shortcut string
+	// Separator makes the item a separating line between groups of items. All other fields are ignored.
+	Separator bool
+	// Checkable items toggle their Checked state when activated.
+	Checkable bool
+	// Checked is the current state of a checkable item.
+	Checked bool
+	// Disabled items can not be activated.
+	Disabled bool
+	// Submenu lists the items of a nested menu, opened from this item.
+	Submenu []*MenuItem
+	// Action is called when the item is activated. It may be nil.
+	Action ActionHandler
+}
+
+// NewMenuSeparator returns a menu item that separates groups of items.
+func NewMenuSeparator() *MenuItem {
+	return &MenuItem{Separator: true}
+}
+
+func (item *MenuItem) isSelectable() bool {
+	return !item.Separator
+}
+
+func (item *MenuItem) isActivatable() bool {
+	return !item.Separator && !item.Disabled && (len(item.Submenu) == 0)
+}
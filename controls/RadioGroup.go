@@ -0,0 +1,156 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+	"github.com/dertseha/jellui/layout"
+)
+
+// RadioGroupItem is the interface type for items within a radio group.
+type RadioGroupItem interface{}
+
+// RadioGroupChangeHandler is a callback for notifying the current selection.
+type RadioGroupChangeHandler func(item RadioGroupItem)
+
+type radioButton struct {
+	cell  *layout.Cell
+	label *Label
+}
+
+// RadioGroup is a control for selecting exactly one of a few items, which are all visible
+// as a vertical list of radio buttons.
+type RadioGroup struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+
+	radioGroupChangeHandler RadioGroupChangeHandler
+
+	items        []RadioGroupItem
+	buttons      []*radioButton
+	selectedItem RadioGroupItem
+	pressedIndex int
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (group *RadioGroup) Dispose() {
+	for _, button := range group.buttons {
+		button.label.Dispose()
+	}
+	group.area.Remove()
+}
+
+// SelectedItem returns the currently selected item. Returns nil if no item is selected.
+func (group *RadioGroup) SelectedItem() RadioGroupItem {
+	return group.selectedItem
+}
+
+// SetSelectedItem changes what is currently selected. Does not fire change handler.
+func (group *RadioGroup) SetSelectedItem(item RadioGroupItem) {
+	group.selectedItem = item
+}
+
+func (group *RadioGroup) selectedIndex() int {
+	for index, item := range group.items {
+		if item == group.selectedItem {
+			return index
+		}
+	}
+	return -1
+}
+
+func (group *RadioGroup) onRender(area *area.Area) {
+	selectedIndex := group.selectedIndex()
+
+	for index, button := range group.buttons {
+		top, bottom := button.cell.Top().Value(), button.cell.Bottom().Value()
+		left := button.cell.Left().Value() + checkBoxGlyphMargin
+		size := bottom - top - 2*checkBoxGlyphMargin
+
+		top += checkBoxGlyphMargin
+		group.rectRenderer.Fill(left, top, left+size, top+size, graphics.RGBA(0.31, 0.56, 0.34, 0.8))
+		renderOutline(group.rectRenderer, left, top, left+size, top+size, graphics.RGBA(0.5, 0.58, 0.33, 1.0))
+		if index == selectedIndex {
+			inset := size / 4
+			group.rectRenderer.Fill(left+inset, top+inset, left+size-inset, top+size-inset,
+				graphics.RGBA(0.5, 0.58, 0.33, 1.0))
+		}
+	}
+}
+
+func (group *RadioGroup) indexAt(event events.PositionalEvent) int {
+	x, y := event.Position()
+
+	for index, button := range group.buttons {
+		if (x >= button.cell.Left().Value()) && (x < button.cell.Right().Value()) &&
+			(y >= button.cell.Top().Value()) && (y < button.cell.Bottom().Value()) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (group *RadioGroup) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !group.area.Contains(mouseEvent.Position()) {
+		group.pressedIndex = -1
+		area.ReleaseFocus()
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		area.RequestFocus()
+		group.pressedIndex = group.indexAt(mouseEvent)
+		consumed = true
+	}
+
+	return
+}
+
+func (group *RadioGroup) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if (group.pressedIndex >= 0) && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		if group.indexAt(mouseEvent) == group.pressedIndex {
+			group.onItemChosen(group.items[group.pressedIndex])
+		}
+		group.pressedIndex = -1
+		consumed = true
+	}
+
+	return
+}
+
+func (group *RadioGroup) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyUp:
+		group.moveSelection(-1)
+	case input.KeyDown:
+		group.moveSelection(1)
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (group *RadioGroup) moveSelection(delta int) {
+	currentIndex := group.selectedIndex()
+	newIndex := currentIndex + delta
+
+	if currentIndex < 0 {
+		newIndex = 0
+	}
+	if (newIndex >= 0) && (newIndex < len(group.items)) {
+		group.onItemChosen(group.items[newIndex])
+	}
+}
+
+func (group *RadioGroup) onItemChosen(item RadioGroupItem) {
+	if item != group.selectedItem {
+		group.SetSelectedItem(item)
+		group.radioGroupChangeHandler(item)
+	}
+}
@@ -0,0 +1,131 @@
+package controls
+
+import (
+	"fmt"
+
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/layout"
+)
+
+// RadioGroupBuilder is a builder for RadioGroup instances.
+type RadioGroupBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	radioGroupChangeHandler RadioGroupChangeHandler
+
+	items        []RadioGroupItem
+	selectedItem RadioGroupItem
+}
+
+// NewRadioGroupBuilder returns a new RadioGroupBuilder instance.
+func NewRadioGroupBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer) *RadioGroupBuilder {
+	builder := &RadioGroupBuilder{
+		areaBuilder:             area.NewAreaBuilder(),
+		rectRenderer:            rectRenderer,
+		labelBuilder:            labelBuilder,
+		radioGroupChangeHandler: func(RadioGroupItem) {}}
+
+	return builder
+}
+
+// Build creates a new RadioGroup instance from the current parameters.
+// The height of the group is evenly shared among the items.
+func (builder *RadioGroupBuilder) Build() *RadioGroup {
+	group := &RadioGroup{
+		rectRenderer:            builder.rectRenderer,
+		radioGroupChangeHandler: builder.radioGroupChangeHandler,
+		items:                   builder.items,
+		selectedItem:            builder.selectedItem,
+		pressedIndex:            -1}
+
+	builder.areaBuilder.OnRender(group.onRender)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, group.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, group.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, group.onKey)
+	builder.areaBuilder.SetFocusable(true)
+	group.area = builder.areaBuilder.Build()
+
+	rows := layout.NewVerticalBox(group.area)
+	builder.labelBuilder.SetParent(group.area)
+	builder.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	for _, item := range builder.items {
+		button := &radioButton{cell: rows.Add(layout.Item{Weight: 1.0})}
+		glyphRight := area.NewAspectRatioAnchor(button.cell.Left(), button.cell.Top(), button.cell.Bottom(), 1.0)
+
+		builder.labelBuilder.SetLeft(area.NewOffsetAnchor(glyphRight, 4))
+		builder.labelBuilder.SetTop(button.cell.Top())
+		builder.labelBuilder.SetRight(button.cell.Right())
+		builder.labelBuilder.SetBottom(button.cell.Bottom())
+		button.label = builder.labelBuilder.Build()
+		button.label.SetText(fmt.Sprintf("%v", item))
+		group.buttons = append(group.buttons, button)
+	}
+
+	return group
+}
+
+// SetParent sets the parent area.
+func (builder *RadioGroupBuilder) SetParent(parent *area.Area) *RadioGroupBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *RadioGroupBuilder) SetLeft(value area.Anchor) *RadioGroupBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *RadioGroupBuilder) SetTop(value area.Anchor) *RadioGroupBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *RadioGroupBuilder) SetRight(value area.Anchor) *RadioGroupBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *RadioGroupBuilder) SetBottom(value area.Anchor) *RadioGroupBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *RadioGroupBuilder) SetTabIndex(value int) *RadioGroupBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the group. Default: ""
+func (builder *RadioGroupBuilder) SetTooltip(text string) *RadioGroupBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithItems sets the list of items to choose from.
+func (builder *RadioGroupBuilder) WithItems(items []RadioGroupItem) *RadioGroupBuilder {
+	builder.items = make([]RadioGroupItem, len(items))
+	copy(builder.items, items)
+	return builder
+}
+
+// WithSelectedItem sets the initially selected item. Default: nil
+func (builder *RadioGroupBuilder) WithSelectedItem(item RadioGroupItem) *RadioGroupBuilder {
+	builder.selectedItem = item
+	return builder
+}
+
+// WithRadioGroupChangeHandler sets the handler that is called when the user changes the selection.
+func (builder *RadioGroupBuilder) WithRadioGroupChangeHandler(handler RadioGroupChangeHandler) *RadioGroupBuilder {
+	builder.radioGroupChangeHandler = handler
+	return builder
+}
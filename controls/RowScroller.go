@@ -0,0 +1,147 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// rowScrollStep is the number of rows scrolled per step of the mouse wheel.
+const rowScrollStep = 3
+
+// rowContent is implemented by controls that show their content in rows of equal height,
+// with labels only for the visible rows.
+type rowContent interface {
+	// rowCount returns the number of rows of the content.
+	rowCount() int
+	// appendRowLabels creates the labels of one further visible row, starting at given top anchor.
+	appendRowLabels(top area.Anchor)
+	// disposeRowLabels removes the labels of all visible rows.
+	disposeRowLabels()
+	// updateRowLabels sets up the labels of the visible rows, starting at the current start index.
+	updateRowLabels()
+}
+
+// rowScroller is a helper for controls that scroll their content vertically by rows.
+// It keeps the index of the first visible row, the labels of the visible rows and a vertical scroll bar.
+// The rows start below an optional offset from the top of the area, such as a header.
+type rowScroller struct {
+	area        *area.Area
+	content     rowContent
+	verticalBar *scrollBar
+
+	rowHeight  float32
+	bodyOffset float32
+
+	startIndex int
+	labelRows  int
+}
+
+// newRowScroller creates a row scroller for given area, which adds its vertical scroll bar to the area.
+func newRowScroller(parent *area.Area, rectRenderer *graphics.RectangleRenderer, content rowContent,
+	rowHeight, bodyOffset float32) *rowScroller {
+	scroller := &rowScroller{
+		area:       parent,
+		content:    content,
+		rowHeight:  rowHeight,
+		bodyOffset: bodyOffset}
+
+	verticalBuilder := area.NewAreaBuilder()
+	verticalBuilder.SetParent(parent)
+	verticalBuilder.SetLeft(area.NewOffsetAnchor(parent.Right(), -scrollBarThickness))
+	verticalBuilder.SetTop(area.NewOffsetAnchor(parent.Top(), bodyOffset))
+	verticalBuilder.SetRight(parent.Right())
+	verticalBuilder.SetBottom(parent.Bottom())
+	scroller.verticalBar = newScrollBar(verticalBuilder, rectRenderer, false, scroller.verticalMetrics, scroller.onScrollBar)
+
+	return scroller
+}
+
+// bodyTop returns the top of the first visible row.
+func (scroller *rowScroller) bodyTop() float32 {
+	return scroller.area.Top().Value() + scroller.bodyOffset
+}
+
+// fullRowCount returns the number of rows that are completely visible, at least one.
+func (scroller *rowScroller) fullRowCount() int {
+	count := int((scroller.area.Bottom().Value() - scroller.bodyTop()) / scroller.rowHeight)
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+// indexAt returns the index of the row at given vertical position within the body.
+func (scroller *rowScroller) indexAt(y float32) int {
+	return scroller.startIndex + int((y-scroller.bodyTop())/scroller.rowHeight)
+}
+
+// onBoundsChange recreates the row labels if the number of visible rows has changed.
+func (scroller *rowScroller) onBoundsChange() {
+	rowCount := scroller.fullRowCount()
+
+	if float32(rowCount)*scroller.rowHeight < (scroller.area.Bottom().Value() - scroller.bodyTop()) {
+		rowCount++
+	}
+	if rowCount != scroller.labelRows {
+		scroller.content.disposeRowLabels()
+		for row := 0; row < rowCount; row++ {
+			scroller.content.appendRowLabels(area.NewOffsetAnchor(scroller.area.Top(), scroller.bodyOffset+float32(row)*scroller.rowHeight))
+		}
+		scroller.labelRows = rowCount
+	}
+	scroller.scrollTo(scroller.startIndex)
+	scroller.content.updateRowLabels()
+}
+
+// dispose removes the labels of all visible rows.
+func (scroller *rowScroller) dispose() {
+	scroller.content.disposeRowLabels()
+	scroller.labelRows = 0
+}
+
+// scrollToIndex scrolls the least amount necessary for the given row to be visible.
+func (scroller *rowScroller) scrollToIndex(index int) {
+	visibleRows := scroller.fullRowCount()
+
+	if index < scroller.startIndex {
+		scroller.scrollTo(index)
+	} else if index >= (scroller.startIndex + visibleRows) {
+		scroller.scrollTo(index - visibleRows + 1)
+	}
+}
+
+// scrollByWheel scrolls a fixed number of rows in the direction of given mouse wheel delta.
+func (scroller *rowScroller) scrollByWheel(delta float32) {
+	if delta < 0 {
+		scroller.scrollTo(scroller.startIndex - rowScrollStep)
+	} else if delta > 0 {
+		scroller.scrollTo(scroller.startIndex + rowScrollStep)
+	}
+}
+
+func (scroller *rowScroller) scrollTo(startIndex int) {
+	maxIndex := scroller.content.rowCount() - scroller.fullRowCount()
+
+	if startIndex > maxIndex {
+		startIndex = maxIndex
+	}
+	if startIndex < 0 {
+		startIndex = 0
+	}
+	if startIndex != scroller.startIndex {
+		scroller.startIndex = startIndex
+		scroller.content.updateRowLabels()
+	}
+	scroller.verticalBar.update()
+}
+
+func (scroller *rowScroller) onScrollBar(position float32) {
+	scroller.scrollTo(int(position/scroller.rowHeight + 0.5))
+}
+
+func (scroller *rowScroller) verticalMetrics() (position, visible, total float32) {
+	position = float32(scroller.startIndex) * scroller.rowHeight
+	visible = float32(scroller.fullRowCount()) * scroller.rowHeight
+	total = float32(scroller.content.rowCount()) * scroller.rowHeight
+	return
+}
@@ -0,0 +1,192 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// scrollBarThickness is the default width of vertical, and height of horizontal scroll bars.
+const scrollBarThickness = 8.0
+
+// scrollBarZIndex places scroll bars in front of the scrolled content.
+const scrollBarZIndex = 1
+
+// scrollMetrics provides the current values represented by a scroll bar:
+// the scroll position, the visible extent and the total extent of the content.
+type scrollMetrics func() (position, visible, total float32)
+
+// scrollHandler is called to request a new scroll position.
+type scrollHandler func(position float32)
+
+// scrollBar is a helper for controls that scroll their content.
+// It renders a track with a draggable thumb and is only visible when the content exceeds the visible extent.
+type scrollBar struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+
+	horizontal bool
+	metrics    scrollMetrics
+	handler    scrollHandler
+
+	dragging   bool
+	grabOffset float32
+}
+
+// newScrollBar creates a scroll bar with the given area builder, which has to provide parent and anchors.
+func newScrollBar(areaBuilder *area.AreaBuilder, rectRenderer *graphics.RectangleRenderer, horizontal bool,
+	metrics scrollMetrics, handler scrollHandler) *scrollBar {
+	bar := &scrollBar{
+		rectRenderer: rectRenderer,
+		horizontal:   horizontal,
+		metrics:      metrics,
+		handler:      handler}
+
+	areaBuilder.SetZIndex(scrollBarZIndex)
+	areaBuilder.OnRender(bar.onRender)
+	areaBuilder.OnEvent(events.MouseButtonDownEventType, bar.onMouseDown)
+	areaBuilder.OnEvent(events.MouseButtonUpEventType, bar.onMouseUp)
+	areaBuilder.OnEvent(events.MouseMoveEventType, bar.onMouseMove)
+	areaBuilder.OnEvent(events.MouseButtonClickedEventType, bar.onMouseClicked)
+	bar.area = areaBuilder.Build()
+	bar.update()
+
+	return bar
+}
+
+// update shows the scroll bar only if the content exceeds the visible extent.
+func (bar *scrollBar) update() {
+	_, visible, total := bar.metrics()
+	active := total > visible
+
+	if bar.area.IsVisible() != active {
+		bar.dragging = false
+		bar.area.SetVisible(active)
+	}
+}
+
+func (bar *scrollBar) track() (start, length float32) {
+	if bar.horizontal {
+		start = bar.area.Left().Value()
+		length = bar.area.Right().Value() - start
+	} else {
+		start = bar.area.Top().Value()
+		length = bar.area.Bottom().Value() - start
+	}
+	return
+}
+
+func (bar *scrollBar) thumb() (start, length float32) {
+	position, visible, total := bar.metrics()
+	trackStart, trackLength := bar.track()
+
+	length = trackLength
+	if total > 0 {
+		length = trackLength * visible / total
+	}
+	if length < scrollBarThickness {
+		length = scrollBarThickness
+	}
+	if length > trackLength {
+		length = trackLength
+	}
+	start = trackStart
+	if total > visible {
+		start += (trackLength - length) * position / (total - visible)
+	}
+	return
+}
+
+func (bar *scrollBar) onRender(area *area.Area) {
+	thumbStart, thumbLength := bar.thumb()
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+	thumbColor := graphics.RGBA(0.31, 0.56, 0.34, 0.8)
+
+	if bar.dragging {
+		thumbColor = graphics.RGBA(0.31, 0.56, 0.34, 0.95)
+	}
+	bar.rectRenderer.Fill(areaLeft, areaTop, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.4))
+	if bar.horizontal {
+		bar.rectRenderer.Fill(thumbStart, areaTop, thumbStart+thumbLength, areaBottom, thumbColor)
+	} else {
+		bar.rectRenderer.Fill(areaLeft, thumbStart, areaRight, thumbStart+thumbLength, thumbColor)
+	}
+}
+
+func (bar *scrollBar) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !bar.area.Contains(mouseEvent.Position()) {
+		area.ReleaseFocus()
+		return
+	}
+	if mouseEvent.AffectedButtons() == input.MousePrimary {
+		mousePosition := bar.axisPosition(mouseEvent)
+		thumbStart, thumbLength := bar.thumb()
+
+		if (mousePosition >= thumbStart) && (mousePosition < (thumbStart + thumbLength)) {
+			area.RequestFocus()
+			bar.dragging = true
+			bar.grabOffset = mousePosition - thumbStart
+		} else {
+			position, visible, _ := bar.metrics()
+			if mousePosition < thumbStart {
+				bar.handler(position - visible)
+			} else {
+				bar.handler(position + visible)
+			}
+		}
+	}
+	consumed = true
+
+	return
+}
+
+func (bar *scrollBar) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if bar.dragging && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		bar.dragging = false
+		area.ReleaseFocus()
+		consumed = true
+	} else {
+		consumed = bar.area.Contains(mouseEvent.Position())
+	}
+
+	return
+}
+
+func (bar *scrollBar) onMouseMove(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseMoveEvent)
+
+	if bar.dragging {
+		_, visible, total := bar.metrics()
+		trackStart, trackLength := bar.track()
+		_, thumbLength := bar.thumb()
+
+		if trackLength > thumbLength {
+			thumbStart := bar.axisPosition(mouseEvent) - bar.grabOffset
+			bar.handler((thumbStart - trackStart) / (trackLength - thumbLength) * (total - visible))
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (bar *scrollBar) onMouseClicked(area *area.Area, event events.Event) bool {
+	return bar.area.Contains(event.(*events.MouseButtonEvent).Position())
+}
+
+func (bar *scrollBar) axisPosition(event events.PositionalEvent) float32 {
+	x, y := event.Position()
+
+	if bar.horizontal {
+		return x
+	}
+	return y
+}
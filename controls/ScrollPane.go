@@ -0,0 +1,134 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+)
+
+// ScrollPane shows a part of a content area, which can be larger than the pane.
+type ScrollPane struct {
+	area    *area.Area
+	content *area.Area
+
+	contentLeft   area.Anchor
+	contentTop    area.Anchor
+	contentRight  area.Anchor
+	contentBottom area.Anchor
+
+	horizontalBar *scrollBar
+	verticalBar   *scrollBar
+
+	scrollStep float32
+}
+
+// Dispose removes the pane, and its content, from the tree.
+func (pane *ScrollPane) Dispose() {
+	pane.area.Remove()
+}
+
+// Content returns the area that is scrolled by the pane. It is the parent for
+// any area to be shown within the pane.
+func (pane *ScrollPane) Content() *area.Area {
+	return pane.content
+}
+
+// SetContentSize sets the size of the content area.
+func (pane *ScrollPane) SetContentSize(width, height float32) {
+	pane.contentRight.RequestValue(pane.contentLeft.Value() + width)
+	pane.contentBottom.RequestValue(pane.contentTop.Value() + height)
+	pane.ScrollTo(pane.ScrollPosition())
+}
+
+// ScrollPosition returns the offset of the visible part within the content.
+func (pane *ScrollPane) ScrollPosition() (x, y float32) {
+	x = pane.area.Left().Value() - pane.contentLeft.Value()
+	y = pane.area.Top().Value() - pane.contentTop.Value()
+	return
+}
+
+// ScrollTo moves the visible part to given offset within the content.
+// The offset is limited to the extent of the content.
+func (pane *ScrollPane) ScrollTo(x, y float32) {
+	limit := func(value, visible, total float32) float32 {
+		if value > (total - visible) {
+			value = total - visible
+		}
+		if value < 0 {
+			value = 0
+		}
+		return value
+	}
+	_, visibleWidth, totalWidth := pane.horizontalMetrics()
+	_, visibleHeight, totalHeight := pane.verticalMetrics()
+
+	pane.moveContent(limit(x, visibleWidth, totalWidth), limit(y, visibleHeight, totalHeight))
+	pane.horizontalBar.update()
+	pane.verticalBar.update()
+}
+
+func (pane *ScrollPane) moveContent(x, y float32) {
+	width := pane.contentRight.Value() - pane.contentLeft.Value()
+	height := pane.contentBottom.Value() - pane.contentTop.Value()
+
+	pane.contentLeft.RequestValue(pane.area.Left().Value() - x)
+	pane.contentTop.RequestValue(pane.area.Top().Value() - y)
+	pane.contentRight.RequestValue(pane.contentLeft.Value() + width)
+	pane.contentBottom.RequestValue(pane.contentTop.Value() + height)
+}
+
+// visibleSize returns the extent of the pane that is not covered by scroll bars.
+// A scroll bar is needed if the content exceeds the pane, or if the content exceeds the space
+// that remains next to the scroll bar for the other direction.
+func (pane *ScrollPane) visibleSize() (width, height float32) {
+	width = pane.area.Right().Value() - pane.area.Left().Value()
+	height = pane.area.Bottom().Value() - pane.area.Top().Value()
+	totalWidth := pane.contentRight.Value() - pane.contentLeft.Value()
+	totalHeight := pane.contentBottom.Value() - pane.contentTop.Value()
+	horizontalNeeded := totalWidth > width
+	verticalNeeded := totalHeight > height
+
+	if horizontalNeeded && !verticalNeeded {
+		verticalNeeded = totalHeight > (height - scrollBarThickness)
+	} else if verticalNeeded && !horizontalNeeded {
+		horizontalNeeded = totalWidth > (width - scrollBarThickness)
+	}
+	if horizontalNeeded {
+		height -= scrollBarThickness
+	}
+	if verticalNeeded {
+		width -= scrollBarThickness
+	}
+	return
+}
+
+func (pane *ScrollPane) horizontalMetrics() (position, visible, total float32) {
+	position, _ = pane.ScrollPosition()
+	visible, _ = pane.visibleSize()
+	total = pane.contentRight.Value() - pane.contentLeft.Value()
+	return
+}
+
+func (pane *ScrollPane) verticalMetrics() (position, visible, total float32) {
+	_, position = pane.ScrollPosition()
+	_, visible = pane.visibleSize()
+	total = pane.contentBottom.Value() - pane.contentTop.Value()
+	return
+}
+
+func (pane *ScrollPane) onBoundsChange(area *area.Area) {
+	pane.ScrollTo(pane.ScrollPosition())
+}
+
+func (pane *ScrollPane) onMouseScroll(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseScrollEvent)
+
+	if pane.area.Contains(mouseEvent.Position()) {
+		dx, dy := mouseEvent.Deltas()
+		x, y := pane.ScrollPosition()
+
+		pane.ScrollTo(x+dx*pane.scrollStep, y+dy*pane.scrollStep)
+		consumed = true
+	}
+
+	return
+}
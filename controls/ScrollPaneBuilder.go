@@ -0,0 +1,124 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// ScrollPaneBuilder is a builder for ScrollPane instances.
+type ScrollPaneBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+
+	contentWidth  float32
+	contentHeight float32
+	scrollStep    float32
+}
+
+// NewScrollPaneBuilder returns a new ScrollPaneBuilder instance.
+func NewScrollPaneBuilder(rectRenderer *graphics.RectangleRenderer) *ScrollPaneBuilder {
+	builder := &ScrollPaneBuilder{
+		areaBuilder:  area.NewAreaBuilder(),
+		rectRenderer: rectRenderer,
+		scrollStep:   20.0}
+
+	return builder
+}
+
+// Build creates a new ScrollPane instance from the current parameters.
+func (builder *ScrollPaneBuilder) Build() *ScrollPane {
+	pane := &ScrollPane{scrollStep: builder.scrollStep}
+
+	builder.areaBuilder.SetClipChildren(true)
+	builder.areaBuilder.OnBoundsChange(pane.onBoundsChange)
+	builder.areaBuilder.OnEvent(events.MouseScrollEventType, pane.onMouseScroll)
+	pane.area = builder.areaBuilder.Build()
+
+	pane.contentLeft = area.NewOffsetAnchor(pane.area.Left(), 0)
+	pane.contentTop = area.NewOffsetAnchor(pane.area.Top(), 0)
+	pane.contentRight = area.NewOffsetAnchor(pane.contentLeft, builder.contentWidth)
+	pane.contentBottom = area.NewOffsetAnchor(pane.contentTop, builder.contentHeight)
+
+	contentBuilder := area.NewAreaBuilder()
+	contentBuilder.SetParent(pane.area)
+	contentBuilder.SetLeft(pane.contentLeft)
+	contentBuilder.SetTop(pane.contentTop)
+	contentBuilder.SetRight(pane.contentRight)
+	contentBuilder.SetBottom(pane.contentBottom)
+	pane.content = contentBuilder.Build()
+
+	horizontalBuilder := area.NewAreaBuilder()
+	horizontalBuilder.SetParent(pane.area)
+	horizontalBuilder.SetLeft(pane.area.Left())
+	horizontalBuilder.SetTop(area.NewOffsetAnchor(pane.area.Bottom(), -scrollBarThickness))
+	horizontalBuilder.SetRight(area.NewOffsetAnchor(pane.area.Right(), -scrollBarThickness))
+	horizontalBuilder.SetBottom(pane.area.Bottom())
+	pane.horizontalBar = newScrollBar(horizontalBuilder, builder.rectRenderer, true, pane.horizontalMetrics,
+		func(position float32) {
+			_, y := pane.ScrollPosition()
+			pane.ScrollTo(position, y)
+		})
+
+	verticalBuilder := area.NewAreaBuilder()
+	verticalBuilder.SetParent(pane.area)
+	verticalBuilder.SetLeft(area.NewOffsetAnchor(pane.area.Right(), -scrollBarThickness))
+	verticalBuilder.SetTop(pane.area.Top())
+	verticalBuilder.SetRight(pane.area.Right())
+	verticalBuilder.SetBottom(area.NewOffsetAnchor(pane.area.Bottom(), -scrollBarThickness))
+	pane.verticalBar = newScrollBar(verticalBuilder, builder.rectRenderer, false, pane.verticalMetrics,
+		func(position float32) {
+			x, _ := pane.ScrollPosition()
+			pane.ScrollTo(x, position)
+		})
+
+	return pane
+}
+
+// SetParent sets the parent area.
+func (builder *ScrollPaneBuilder) SetParent(parent *area.Area) *ScrollPaneBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *ScrollPaneBuilder) SetLeft(value area.Anchor) *ScrollPaneBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *ScrollPaneBuilder) SetTop(value area.Anchor) *ScrollPaneBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *ScrollPaneBuilder) SetRight(value area.Anchor) *ScrollPaneBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *ScrollPaneBuilder) SetBottom(value area.Anchor) *ScrollPaneBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the pane. Default: ""
+func (builder *ScrollPaneBuilder) SetTooltip(text string) *ScrollPaneBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithContentSize sets the initial size of the content area.
+func (builder *ScrollPaneBuilder) WithContentSize(width, height float32) *ScrollPaneBuilder {
+	builder.contentWidth, builder.contentHeight = width, height
+	return builder
+}
+
+// WithScrollStep sets the distance to scroll per step of the mouse wheel. Default: 20.0
+func (builder *ScrollPaneBuilder) WithScrollStep(value float32) *ScrollPaneBuilder {
+	builder.scrollStep = value
+	return builder
+}
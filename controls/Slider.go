@@ -26,6 +26,8 @@ type Slider struct {
 
 	valueUndefined bool
 	value          int64
+
+	dragging bool
 }
 
 // Dispose releases all resources and removes the area from the tree.
@@ -80,8 +82,13 @@ func (slider *Slider) onRender(area *area.Area) {
 
 func (slider *Slider) onMouseButtonDown(area *area.Area, event events.Event) bool {
 	mouseEvent := event.(*events.MouseButtonEvent)
+	if !slider.area.Contains(mouseEvent.Position()) {
+		area.ReleaseFocus()
+		return false
+	}
 	if mouseEvent.AffectedButtons() == input.MousePrimary {
 		area.RequestFocus()
+		slider.dragging = true
 		slider.updateValueOnMouseEvent(mouseEvent)
 	}
 	return true
@@ -89,38 +96,70 @@ func (slider *Slider) onMouseButtonDown(area *area.Area, event events.Event) boo
 
 func (slider *Slider) onMouseButtonUp(area *area.Area, event events.Event) bool {
 	mouseEvent := event.(*events.MouseButtonEvent)
-	if slider.area.HasFocus() && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+	if slider.dragging && (mouseEvent.AffectedButtons() == input.MousePrimary) {
 		area.ReleaseFocus()
+		slider.dragging = false
 		slider.updateValueOnMouseEvent(mouseEvent)
 		slider.onValueChange(slider.value)
+		return true
 	}
-	return true
+	return slider.area.Contains(mouseEvent.Position())
 }
 
 func (slider *Slider) onMouseMove(area *area.Area, event events.Event) bool {
 	mouseEvent := event.(*events.MouseMoveEvent)
-	if area.HasFocus() && (mouseEvent.Buttons() == input.MousePrimary) {
+	if slider.dragging && (mouseEvent.Buttons() == input.MousePrimary) {
 		slider.updateValueOnMouseEvent(mouseEvent)
+		return true
 	}
-	return true
+	return slider.area.Contains(mouseEvent.Position())
 }
 
 func (slider *Slider) onMouseScroll(area *area.Area, event events.Event) bool {
 	mouseEvent := event.(*events.MouseScrollEvent)
 
+	if !slider.area.Contains(mouseEvent.Position()) {
+		return false
+	}
 	if !slider.valueUndefined {
 		_, dy := mouseEvent.Deltas()
 
-		if (dy < 0) && (slider.value > slider.valueMin) {
-			slider.onValueChange(slider.value - 1)
-		} else if (dy > 0) && (slider.value < slider.valueMax) {
-			slider.onValueChange(slider.value + 1)
+		if dy < 0 {
+			slider.step(-1)
+		} else if dy > 0 {
+			slider.step(1)
 		}
 	}
 
 	return true
 }
 
+func (slider *Slider) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	if !slider.valueUndefined {
+		consumed = true
+		switch keyEvent.Key() {
+		case input.KeyLeft, input.KeyDown:
+			slider.step(-1)
+		case input.KeyRight, input.KeyUp:
+			slider.step(1)
+		default:
+			consumed = false
+		}
+	}
+
+	return
+}
+
+func (slider *Slider) step(delta int64) {
+	newValue := slider.value + delta
+
+	if (newValue >= slider.valueMin) && (newValue <= slider.valueMax) {
+		slider.onValueChange(newValue)
+	}
+}
+
 func (slider *Slider) updateValueOnMouseEvent(mouseEvent events.PositionalEvent) {
 	areaLeft := slider.area.Left().Value()
 	areaRight := slider.area.Right().Value()
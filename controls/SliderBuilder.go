@@ -44,6 +44,8 @@ func (builder *SliderBuilder) Build() *Slider {
 	builder.areaBuilder.OnEvent(events.MouseMoveEventType, slider.onMouseMove)
 	builder.areaBuilder.OnEvent(events.MouseScrollEventType, slider.onMouseScroll)
 	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, slider.onKey)
+	builder.areaBuilder.SetFocusable(true)
 	slider.area = builder.areaBuilder.Build()
 
 	builder.labelBuilder.SetParent(slider.area)
@@ -88,6 +90,18 @@ func (builder *SliderBuilder) SetBottom(value area.Anchor) *SliderBuilder {
 	return builder
 }
 
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *SliderBuilder) SetTabIndex(value int) *SliderBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the slider. Default: ""
+func (builder *SliderBuilder) SetTooltip(text string) *SliderBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
 // WithSliderChangeHandler sets the handler for a value change.
 func (builder *SliderBuilder) WithSliderChangeHandler(handler SliderChangeHandler) *SliderBuilder {
 	builder.sliderChangeHandler = handler
@@ -0,0 +1,341 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// tabPadding is the horizontal space between the edges of a tab header and its content.
+const tabPadding = 6.0
+
+// tabCloseSize is the width of the close button within the header of closable tabs.
+const tabCloseSize = 10.0
+
+// TabChangeHandler is a callback for notifying the index of the currently selected tab.
+// The index is -1 if there are no tabs left.
+type TabChangeHandler func(index int)
+
+// TabCloseHandler is a callback for notifying that a tab is closed by the user.
+// It is called with the content area of the tab before the tab is removed.
+type TabCloseHandler func(content *area.Area)
+
+type tabPanelTab struct {
+	title    string
+	closable bool
+	content  *area.Area
+
+	left       area.Anchor
+	right      area.Anchor
+	label      *Label
+	closeLabel *Label
+}
+
+// TabPanel is a control for switching between pages of controls.
+// It shows a row of tab headers, and the content area of only the selected tab.
+// Tabs can be reordered by dragging their headers.
+type TabPanel struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	headerHeight float32
+
+	tabChangeHandler TabChangeHandler
+	tabCloseHandler  TabCloseHandler
+
+	tabs          []*tabPanelTab
+	selectedIndex int
+	draggedIndex  int
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (panel *TabPanel) Dispose() {
+	for _, tab := range panel.tabs {
+		panel.disposeTab(tab)
+	}
+	panel.tabs = nil
+	panel.area.Remove()
+}
+
+// AddTab appends a new tab with given title and returns its content area.
+// Controls of the tab are to be created with the content area as parent.
+// The first tab becomes selected. Does not fire change handler.
+func (panel *TabPanel) AddTab(title string, closable bool) *area.Area {
+	newTab := &tabPanelTab{
+		title:    title,
+		closable: closable,
+		left:     area.NewOffsetAnchor(panel.area.Left(), 0),
+		right:    area.NewOffsetAnchor(panel.area.Left(), 0)}
+	headerTop := panel.area.Top()
+	headerBottom := area.NewOffsetAnchor(panel.area.Top(), panel.headerHeight)
+
+	contentBuilder := area.NewAreaBuilder()
+	contentBuilder.SetParent(panel.area)
+	contentBuilder.SetLeft(panel.area.Left())
+	contentBuilder.SetTop(headerBottom)
+	contentBuilder.SetRight(panel.area.Right())
+	contentBuilder.SetBottom(panel.area.Bottom())
+	contentBuilder.SetVisible(false)
+	newTab.content = contentBuilder.Build()
+
+	closeExtent := float32(0.0)
+	if closable {
+		closeExtent = tabCloseSize
+	}
+	panel.labelBuilder.SetParent(panel.area)
+	panel.labelBuilder.AlignedHorizontallyBy(CenterAligner)
+	panel.labelBuilder.SetTop(headerTop)
+	panel.labelBuilder.SetBottom(headerBottom)
+	panel.labelBuilder.SetLeft(area.NewOffsetAnchor(newTab.left, tabPadding))
+	panel.labelBuilder.SetRight(area.NewOffsetAnchor(newTab.right, -tabPadding-closeExtent))
+	newTab.label = panel.labelBuilder.Build()
+	newTab.label.SetText(title)
+	if closable {
+		panel.labelBuilder.SetLeft(area.NewOffsetAnchor(newTab.right, -tabPadding-tabCloseSize))
+		panel.labelBuilder.SetRight(area.NewOffsetAnchor(newTab.right, -tabPadding))
+		newTab.closeLabel = panel.labelBuilder.Build()
+		newTab.closeLabel.SetText("x")
+	}
+
+	panel.tabs = append(panel.tabs, newTab)
+	panel.layoutTabs()
+	if panel.selectedIndex < 0 {
+		panel.SetSelectedTab(0)
+	}
+
+	return newTab.content
+}
+
+// RemoveTab removes the tab at given index, including its content area.
+// If the selected tab is removed, its neighbour becomes selected. Does not fire change handler.
+func (panel *TabPanel) RemoveTab(index int) {
+	if (index >= 0) && (index < len(panel.tabs)) {
+		panel.disposeTab(panel.tabs[index])
+		panel.tabs = append(panel.tabs[:index], panel.tabs[index+1:]...)
+		panel.layoutTabs()
+
+		newSelection := panel.selectedIndex
+		if index < panel.selectedIndex {
+			newSelection--
+		} else if newSelection >= len(panel.tabs) {
+			newSelection = len(panel.tabs) - 1
+		}
+		panel.selectedIndex = -1
+		panel.SetSelectedTab(newSelection)
+	}
+}
+
+// MoveTab moves the tab at given index to the new position. The selected tab stays selected.
+func (panel *TabPanel) MoveTab(from, to int) {
+	if (from >= 0) && (from < len(panel.tabs)) && (to >= 0) && (to < len(panel.tabs)) && (from != to) {
+		selectedTab := panel.tabs[panel.selectedIndex]
+		movedTab := panel.tabs[from]
+
+		panel.tabs = append(panel.tabs[:from], panel.tabs[from+1:]...)
+		panel.tabs = append(panel.tabs[:to], append([]*tabPanelTab{movedTab}, panel.tabs[to:]...)...)
+		for index, tab := range panel.tabs {
+			if tab == selectedTab {
+				panel.selectedIndex = index
+			}
+		}
+		panel.layoutTabs()
+	}
+}
+
+// TabCount returns the number of tabs.
+func (panel *TabPanel) TabCount() int {
+	return len(panel.tabs)
+}
+
+// TabContent returns the content area of the tab at given index.
+func (panel *TabPanel) TabContent(index int) *area.Area {
+	return panel.tabs[index].content
+}
+
+// SetTabTitle changes the title shown in the header of the tab at given index.
+func (panel *TabPanel) SetTabTitle(index int, title string) {
+	panel.tabs[index].title = title
+	panel.tabs[index].label.SetText(title)
+	panel.layoutTabs()
+}
+
+// SelectedTab returns the index of the currently selected tab. Returns -1 if there are no tabs.
+func (panel *TabPanel) SelectedTab() int {
+	return panel.selectedIndex
+}
+
+// SetSelectedTab shows the content of the tab at given index and hides all others. Does not fire change handler.
+func (panel *TabPanel) SetSelectedTab(index int) {
+	if (index >= 0) && (index < len(panel.tabs)) && (index != panel.selectedIndex) {
+		for tabIndex, tab := range panel.tabs {
+			tab.content.SetVisible(tabIndex == index)
+		}
+		panel.selectedIndex = index
+	} else if len(panel.tabs) == 0 {
+		panel.selectedIndex = -1
+	}
+}
+
+func (panel *TabPanel) onRender(area *area.Area) {
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+	headerBottom := areaTop + panel.headerHeight
+	lineColor := graphics.RGBA(0.5, 0.58, 0.33, 1.0)
+
+	panel.rectRenderer.Fill(areaLeft, headerBottom, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.5))
+	for index, tab := range panel.tabs {
+		color := graphics.RGBA(0.31, 0.56, 0.34, 0.8)
+		if index == panel.selectedIndex {
+			color = graphics.RGBA(0.31, 0.56, 0.34, 0.95)
+		}
+		panel.rectRenderer.Fill(tab.left.Value(), areaTop, tab.right.Value(), headerBottom, color)
+		renderOutline(panel.rectRenderer, tab.left.Value(), areaTop, tab.right.Value(), headerBottom+1, lineColor)
+	}
+	panel.rectRenderer.Fill(areaLeft, headerBottom, areaRight, headerBottom+1, lineColor)
+}
+
+func (panel *TabPanel) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !panel.headerContains(mouseEvent) {
+		if area.HasFocus() {
+			area.ReleaseFocus()
+		}
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		mouseX, _ := mouseEvent.Position()
+		index := panel.tabIndexAt(mouseX)
+
+		area.RequestFocus()
+		if index >= 0 {
+			tab := panel.tabs[index]
+			if tab.closable && (mouseX >= tab.closeLabel.area.Left().Value()) && (mouseX < tab.closeLabel.area.Right().Value()) {
+				panel.closeByUser(index)
+			} else {
+				panel.selectByUser(index)
+				panel.draggedIndex = index
+			}
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (panel *TabPanel) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if (panel.draggedIndex >= 0) && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		panel.draggedIndex = -1
+		consumed = true
+	}
+
+	return
+}
+
+func (panel *TabPanel) onMouseMove(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseMoveEvent)
+
+	if panel.draggedIndex >= 0 {
+		mouseX, _ := mouseEvent.Position()
+		for (panel.draggedIndex > 0) && (mouseX < panel.tabCenter(panel.draggedIndex-1)) {
+			panel.MoveTab(panel.draggedIndex, panel.draggedIndex-1)
+			panel.draggedIndex--
+		}
+		for (panel.draggedIndex < len(panel.tabs)-1) && (mouseX >= panel.tabCenter(panel.draggedIndex+1)) {
+			panel.MoveTab(panel.draggedIndex, panel.draggedIndex+1)
+			panel.draggedIndex++
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (panel *TabPanel) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyLeft:
+		panel.selectByUser(panel.selectedIndex - 1)
+	case input.KeyRight:
+		panel.selectByUser(panel.selectedIndex + 1)
+	case input.KeyHome:
+		panel.selectByUser(0)
+	case input.KeyEnd:
+		panel.selectByUser(len(panel.tabs) - 1)
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (panel *TabPanel) headerContains(event events.PositionalEvent) bool {
+	x, y := event.Position()
+
+	return (x >= panel.area.Left().Value()) && (x < panel.area.Right().Value()) &&
+		(y >= panel.area.Top().Value()) && (y < panel.area.Top().Value()+panel.headerHeight)
+}
+
+// tabIndexAt returns the index of the tab with a header at given horizontal position, or -1 if there is none.
+func (panel *TabPanel) tabIndexAt(x float32) int {
+	for index, tab := range panel.tabs {
+		if (x >= tab.left.Value()) && (x < tab.right.Value()) {
+			return index
+		}
+	}
+	return -1
+}
+
+// tabCenter returns the horizontal center of the header of the tab at given index.
+// A dragged tab swaps places with a neighbour once the cursor passed the center of the neighbour.
+func (panel *TabPanel) tabCenter(index int) float32 {
+	tab := panel.tabs[index]
+	return (tab.left.Value() + tab.right.Value()) / 2
+}
+
+func (panel *TabPanel) selectByUser(index int) {
+	if (index >= 0) && (index < len(panel.tabs)) && (index != panel.selectedIndex) {
+		panel.SetSelectedTab(index)
+		panel.tabChangeHandler(index)
+	}
+}
+
+func (panel *TabPanel) closeByUser(index int) {
+	previousSelection := panel.tabs[panel.selectedIndex]
+
+	panel.tabCloseHandler(panel.tabs[index].content)
+	panel.RemoveTab(index)
+	if (panel.selectedIndex < 0) || (panel.tabs[panel.selectedIndex] != previousSelection) {
+		panel.tabChangeHandler(panel.selectedIndex)
+	}
+}
+
+// layoutTabs places the headers of all tabs next to each other, each as wide as its title requires.
+func (panel *TabPanel) layoutTabs() {
+	tabLeft := panel.area.Left().Value()
+
+	for _, tab := range panel.tabs {
+		width, _ := panel.labelBuilder.measure(tab.title)
+		width += 2 * tabPadding
+		if tab.closable {
+			width += tabCloseSize
+		}
+		tab.left.RequestValue(tabLeft)
+		tab.right.RequestValue(tabLeft + width)
+		tabLeft += width
+	}
+}
+
+func (panel *TabPanel) disposeTab(tab *tabPanelTab) {
+	tab.label.Dispose()
+	if tab.closeLabel != nil {
+		tab.closeLabel.Dispose()
+	}
+	tab.content.Remove()
+}
@@ -0,0 +1,116 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// TabPanelBuilder is a builder for TabPanel instances.
+type TabPanelBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	headerHeight float32
+
+	tabChangeHandler TabChangeHandler
+	tabCloseHandler  TabCloseHandler
+}
+
+// NewTabPanelBuilder returns a new TabPanelBuilder instance.
+func NewTabPanelBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer) *TabPanelBuilder {
+	builder := &TabPanelBuilder{
+		areaBuilder:      area.NewAreaBuilder(),
+		rectRenderer:     rectRenderer,
+		labelBuilder:     labelBuilder,
+		headerHeight:     20,
+		tabChangeHandler: func(int) {},
+		tabCloseHandler:  func(*area.Area) {}}
+
+	return builder
+}
+
+// Build creates a new TabPanel instance from the current parameters.
+// The panel starts without tabs; they are added with AddTab.
+func (builder *TabPanelBuilder) Build() *TabPanel {
+	panel := &TabPanel{
+		rectRenderer:     builder.rectRenderer,
+		labelBuilder:     builder.labelBuilder,
+		headerHeight:     builder.headerHeight,
+		tabChangeHandler: builder.tabChangeHandler,
+		tabCloseHandler:  builder.tabCloseHandler,
+		selectedIndex:    -1,
+		draggedIndex:     -1}
+
+	builder.areaBuilder.SetClipChildren(true)
+	builder.areaBuilder.OnRender(panel.onRender)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, panel.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, panel.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseMoveEventType, panel.onMouseMove)
+	builder.areaBuilder.OnEvent(events.KeyEventType, panel.onKey)
+	builder.areaBuilder.SetFocusable(true)
+	panel.area = builder.areaBuilder.Build()
+
+	return panel
+}
+
+// SetParent sets the parent area.
+func (builder *TabPanelBuilder) SetParent(parent *area.Area) *TabPanelBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *TabPanelBuilder) SetLeft(value area.Anchor) *TabPanelBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *TabPanelBuilder) SetTop(value area.Anchor) *TabPanelBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *TabPanelBuilder) SetRight(value area.Anchor) *TabPanelBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *TabPanelBuilder) SetBottom(value area.Anchor) *TabPanelBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *TabPanelBuilder) SetTabIndex(value int) *TabPanelBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the tab headers. Default: ""
+func (builder *TabPanelBuilder) SetTooltip(text string) *TabPanelBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithHeaderHeight sets the height of the row of tab headers. Default: 20
+func (builder *TabPanelBuilder) WithHeaderHeight(value float32) *TabPanelBuilder {
+	builder.headerHeight = value
+	return builder
+}
+
+// WithTabChangeHandler sets the handler for a change of the selected tab.
+func (builder *TabPanelBuilder) WithTabChangeHandler(handler TabChangeHandler) *TabPanelBuilder {
+	builder.tabChangeHandler = handler
+	return builder
+}
+
+// WithTabCloseHandler sets the handler for a tab being closed by the user.
+func (builder *TabPanelBuilder) WithTabCloseHandler(handler TabCloseHandler) *TabPanelBuilder {
+	builder.tabCloseHandler = handler
+	return builder
+}
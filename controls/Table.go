@@ -0,0 +1,404 @@
+package controls
+
+import (
+	"sort"
+
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// tableMinColumnWidth is the smallest width a column can be resized to.
+const tableMinColumnWidth = 16.0
+
+// tableResizeTolerance is the distance to a column edge within the header that starts resizing.
+const tableResizeTolerance = 3.0
+
+// TableDataSource provides the cells shown in a table.
+// A table only requests the cells of the rows that are currently visible, and all rows for sorting.
+type TableDataSource interface {
+	// RowCount returns the number of available rows.
+	RowCount() int
+	// CellText returns the text to display for given row and column.
+	CellText(row, column int) string
+}
+
+// TableComparator compares two rows of the data source for sorting.
+// It returns true if rowA is to be placed before rowB in ascending order.
+type TableComparator func(rowA, rowB int) bool
+
+// NewTextComparator returns a comparator that orders the rows by the cell texts of the given column.
+func NewTextComparator(dataSource TableDataSource, column int) TableComparator {
+	return func(rowA, rowB int) bool {
+		return dataSource.CellText(rowA, column) < dataSource.CellText(rowB, column)
+	}
+}
+
+// TableSelectionChangeHandler is a callback for notifying the currently selected row of the data source.
+// The row is -1 if no row is selected.
+type TableSelectionChangeHandler func(row int)
+
+// TableColumn describes one column of a table.
+type TableColumn struct {
+	// Title is shown in the header.
+	Title string
+	// Width is the initial width of the column.
+	Width float32
+	// Comparator is used when sorting by the column. Columns without a comparator are not sortable.
+	Comparator TableComparator
+}
+
+// Table is a control for displaying rows of data in columns.
+// Columns can be resized by dragging their edge in the header, and sorted by clicking their title.
+// Only the visible rows are rendered, which are queried from a data source.
+type Table struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+	scroller     *rowScroller
+
+	dataSource TableDataSource
+	columns    []TableColumn
+	rowHeight  float32
+
+	tableSelectionChangeHandler TableSelectionChangeHandler
+
+	columnEdges  []area.Anchor
+	headerLabels []*Label
+	cellLabels   [][]*Label
+
+	sortColumn    int
+	sortAscending bool
+	sortedRows    []int
+	rowPositions  []int
+
+	selectedRow    int
+	resizingColumn int
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (table *Table) Dispose() {
+	for _, label := range table.headerLabels {
+		label.Dispose()
+	}
+	table.scroller.dispose()
+	table.area.Remove()
+}
+
+// Refresh queries the data source again and re-applies the sorting. It is to be called after the data has changed.
+// The selection is dropped if the selected row no longer exists. Does not fire change handler.
+func (table *Table) Refresh() {
+	if table.selectedRow >= table.dataSource.RowCount() {
+		table.selectedRow = -1
+	}
+	table.sortRows()
+	table.scroller.scrollTo(table.scroller.startIndex)
+	table.updateRowLabels()
+}
+
+// SelectedRow returns the currently selected row of the data source. Returns -1 if no row is selected.
+func (table *Table) SelectedRow() int {
+	return table.selectedRow
+}
+
+// SetSelectedRow changes which row of the data source is selected; -1 clears the selection.
+// Does not fire change handler.
+func (table *Table) SetSelectedRow(row int) {
+	if (row < 0) || (row >= table.dataSource.RowCount()) {
+		row = -1
+	}
+	table.selectedRow = row
+}
+
+// SortBy orders the rows by given column, using the comparator of the column.
+// A negative column, or one without comparator, restores the order of the data source.
+func (table *Table) SortBy(column int, ascending bool) {
+	if (column >= len(table.columns)) || ((column >= 0) && (table.columns[column].Comparator == nil)) {
+		column = -1
+	}
+	table.sortColumn = column
+	table.sortAscending = ascending
+	table.sortRows()
+	table.updateHeaderLabels()
+	table.updateRowLabels()
+}
+
+// ColumnWidth returns the current width of the given column.
+func (table *Table) ColumnWidth(column int) float32 {
+	return table.columnEdges[column+1].Value() - table.columnEdges[column].Value()
+}
+
+func (table *Table) onRender(area *area.Area) {
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+	bodyTop := table.scroller.bodyTop()
+	rowRight := areaRight - scrollBarThickness
+	lineColor := graphics.RGBA(0.5, 0.58, 0.33, 1.0)
+
+	table.rectRenderer.Fill(areaLeft, areaTop, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.5))
+	table.rectRenderer.Fill(areaLeft, areaTop, areaRight, bodyTop, graphics.RGBA(0.31, 0.56, 0.34, 0.8))
+	if table.selectedRow >= 0 {
+		row := table.positionOf(table.selectedRow) - table.scroller.startIndex
+		if (row >= 0) && (row < len(table.cellLabels)) {
+			rowTop := bodyTop + float32(row)*table.rowHeight
+			rowBottom := rowTop + table.rowHeight
+			if rowBottom > areaBottom {
+				rowBottom = areaBottom
+			}
+			table.rectRenderer.Fill(areaLeft, rowTop, rowRight, rowBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+			if area.HasFocus() {
+				renderOutline(table.rectRenderer, areaLeft, rowTop, rowRight, rowBottom, lineColor)
+			}
+		}
+	}
+	table.rectRenderer.Fill(areaLeft, bodyTop-1, areaRight, bodyTop, lineColor)
+	for _, edge := range table.columnEdges[1:] {
+		edgeX := edge.Value()
+		table.rectRenderer.Fill(edgeX-1, areaTop, edgeX, areaBottom, lineColor)
+	}
+}
+
+func (table *Table) onBoundsChange(area *area.Area) {
+	table.scroller.onBoundsChange()
+}
+
+func (table *Table) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !table.area.Contains(mouseEvent.Position()) {
+		if area.HasFocus() {
+			area.ReleaseFocus()
+		}
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		mouseX, mouseY := mouseEvent.Position()
+
+		area.RequestFocus()
+		if mouseY < table.scroller.bodyTop() {
+			table.onHeaderPressed(mouseX)
+		} else {
+			position := table.scroller.indexAt(mouseY)
+			if position < table.dataSource.RowCount() {
+				table.selectByUser(table.rowAt(position))
+			}
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (table *Table) onHeaderPressed(mouseX float32) {
+	for column := len(table.columns) - 1; column >= 0; column-- {
+		edgeX := table.columnEdges[column+1].Value()
+		if (mouseX >= edgeX-tableResizeTolerance) && (mouseX <= edgeX+tableResizeTolerance) {
+			table.resizingColumn = column
+			return
+		}
+	}
+	for column := range table.columns {
+		if (mouseX >= table.columnEdges[column].Value()) && (mouseX < table.columnEdges[column+1].Value()) {
+			if table.columns[column].Comparator != nil {
+				table.SortBy(column, (table.sortColumn != column) || !table.sortAscending)
+			}
+		}
+	}
+}
+
+func (table *Table) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if (table.resizingColumn >= 0) && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		table.resizingColumn = -1
+		consumed = true
+	}
+
+	return
+}
+
+func (table *Table) onMouseMove(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseMoveEvent)
+
+	if table.resizingColumn >= 0 {
+		mouseX, _ := mouseEvent.Position()
+		minRight := table.columnEdges[table.resizingColumn].Value() + tableMinColumnWidth
+		if mouseX < minRight {
+			mouseX = minRight
+		}
+		table.columnEdges[table.resizingColumn+1].RequestValue(mouseX)
+		consumed = true
+	}
+
+	return
+}
+
+func (table *Table) onMouseScroll(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseScrollEvent)
+
+	if table.area.Contains(mouseEvent.Position()) {
+		_, dy := mouseEvent.Deltas()
+		table.scroller.scrollByWheel(dy)
+		consumed = true
+	}
+
+	return
+}
+
+func (table *Table) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+	position := -1
+
+	if table.selectedRow >= 0 {
+		position = table.positionOf(table.selectedRow)
+	}
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyUp:
+		table.moveSelection(position - 1)
+	case input.KeyDown:
+		table.moveSelection(position + 1)
+	case input.KeyPageUp:
+		table.moveSelection(position - table.scroller.fullRowCount())
+	case input.KeyPageDown:
+		table.moveSelection(position + table.scroller.fullRowCount())
+	case input.KeyHome:
+		table.moveSelection(0)
+	case input.KeyEnd:
+		table.moveSelection(table.dataSource.RowCount() - 1)
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (table *Table) moveSelection(position int) {
+	count := table.dataSource.RowCount()
+
+	if position >= count {
+		position = count - 1
+	}
+	if position < 0 {
+		position = 0
+	}
+	if count > 0 {
+		table.selectByUser(table.rowAt(position))
+	}
+}
+
+func (table *Table) selectByUser(row int) {
+	table.scroller.scrollToIndex(table.positionOf(row))
+	if row != table.selectedRow {
+		table.selectedRow = row
+		table.tableSelectionChangeHandler(row)
+	}
+}
+
+// rowAt returns the row of the data source that is displayed at given position.
+func (table *Table) rowAt(position int) int {
+	if table.sortedRows == nil {
+		return position
+	}
+	return table.sortedRows[position]
+}
+
+// positionOf returns the position at which given row of the data source is displayed.
+// Returns -1 for rows that are not displayed.
+func (table *Table) positionOf(row int) int {
+	if (row < 0) || (row >= table.dataSource.RowCount()) {
+		return -1
+	}
+	if table.rowPositions == nil {
+		return row
+	}
+	if row >= len(table.rowPositions) {
+		return -1
+	}
+	return table.rowPositions[row]
+}
+
+func (table *Table) sortRows() {
+	table.sortedRows = nil
+	table.rowPositions = nil
+	if table.sortColumn >= 0 {
+		less := table.columns[table.sortColumn].Comparator
+		rows := make([]int, table.dataSource.RowCount())
+		for row := range rows {
+			rows[row] = row
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			if table.sortAscending {
+				return less(rows[i], rows[j])
+			}
+			return less(rows[j], rows[i])
+		})
+		table.sortedRows = rows
+		table.rowPositions = make([]int, len(rows))
+		for position, row := range rows {
+			table.rowPositions[row] = position
+		}
+	}
+}
+
+func (table *Table) rowCount() int {
+	return table.dataSource.RowCount()
+}
+
+func (table *Table) buildCellLabel(column int, top area.Anchor) *Label {
+	table.labelBuilder.SetParent(table.area)
+	table.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	table.labelBuilder.SetLeft(area.NewOffsetAnchor(table.columnEdges[column], 4))
+	table.labelBuilder.SetRight(area.NewOffsetAnchor(table.columnEdges[column+1], -4))
+	table.labelBuilder.SetTop(top)
+	table.labelBuilder.SetBottom(area.NewOffsetAnchor(top, table.rowHeight))
+	return table.labelBuilder.Build()
+}
+
+func (table *Table) appendRowLabels(top area.Anchor) {
+	rowLabels := make([]*Label, len(table.columns))
+
+	for column := range table.columns {
+		rowLabels[column] = table.buildCellLabel(column, top)
+	}
+	table.cellLabels = append(table.cellLabels, rowLabels)
+}
+
+func (table *Table) disposeRowLabels() {
+	for _, rowLabels := range table.cellLabels {
+		for _, label := range rowLabels {
+			label.Dispose()
+		}
+	}
+	table.cellLabels = nil
+}
+
+func (table *Table) updateHeaderLabels() {
+	for column, label := range table.headerLabels {
+		text := table.columns[column].Title
+		if column == table.sortColumn {
+			if table.sortAscending {
+				text += " ^"
+			} else {
+				text += " v"
+			}
+		}
+		label.SetText(text)
+	}
+}
+
+func (table *Table) updateRowLabels() {
+	count := table.dataSource.RowCount()
+
+	for row, rowLabels := range table.cellLabels {
+		position := table.scroller.startIndex + row
+		for column, label := range rowLabels {
+			if position < count {
+				label.SetText(table.dataSource.CellText(table.rowAt(position), column))
+			} else {
+				label.SetText("")
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+type emptyTableDataSource struct{}
+
+func (source emptyTableDataSource) RowCount() int {
+	return 0
+}
+
+func (source emptyTableDataSource) CellText(row, column int) string {
+	return ""
+}
+
+// TableBuilder is a builder for Table instances.
+type TableBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	dataSource TableDataSource
+	columns    []TableColumn
+	rowHeight  float32
+
+	tableSelectionChangeHandler TableSelectionChangeHandler
+}
+
+// NewTableBuilder returns a new TableBuilder instance.
+func NewTableBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer) *TableBuilder {
+	builder := &TableBuilder{
+		areaBuilder:                 area.NewAreaBuilder(),
+		rectRenderer:                rectRenderer,
+		labelBuilder:                labelBuilder,
+		dataSource:                  emptyTableDataSource{},
+		rowHeight:                   20,
+		tableSelectionChangeHandler: func(int) {}}
+
+	return builder
+}
+
+// Build creates a new Table instance from the current parameters.
+func (builder *TableBuilder) Build() *Table {
+	table := &Table{
+		rectRenderer:                builder.rectRenderer,
+		labelBuilder:                builder.labelBuilder,
+		dataSource:                  builder.dataSource,
+		columns:                     builder.columns,
+		rowHeight:                   builder.rowHeight,
+		tableSelectionChangeHandler: builder.tableSelectionChangeHandler,
+		sortColumn:                  -1,
+		selectedRow:                 -1,
+		resizingColumn:              -1}
+
+	builder.areaBuilder.SetClipChildren(true)
+	builder.areaBuilder.OnRender(table.onRender)
+	builder.areaBuilder.OnBoundsChange(table.onBoundsChange)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, table.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, table.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseMoveEventType, table.onMouseMove)
+	builder.areaBuilder.OnEvent(events.MouseScrollEventType, table.onMouseScroll)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, table.onKey)
+	builder.areaBuilder.SetFocusable(true)
+	table.area = builder.areaBuilder.Build()
+
+	lastEdge := area.NewOffsetAnchor(table.area.Left(), 0)
+	table.columnEdges = []area.Anchor{lastEdge}
+	for _, column := range table.columns {
+		lastEdge = area.NewOffsetAnchor(lastEdge, column.Width)
+		table.columnEdges = append(table.columnEdges, lastEdge)
+	}
+	table.headerLabels = make([]*Label, len(table.columns))
+	for column := range table.columns {
+		table.headerLabels[column] = table.buildCellLabel(column, table.area.Top())
+	}
+	table.updateHeaderLabels()
+	table.scroller = newRowScroller(table.area, builder.rectRenderer, table, table.rowHeight, table.rowHeight)
+
+	return table
+}
+
+// SetParent sets the parent area.
+func (builder *TableBuilder) SetParent(parent *area.Area) *TableBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *TableBuilder) SetLeft(value area.Anchor) *TableBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *TableBuilder) SetTop(value area.Anchor) *TableBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *TableBuilder) SetRight(value area.Anchor) *TableBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *TableBuilder) SetBottom(value area.Anchor) *TableBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *TableBuilder) SetTabIndex(value int) *TableBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the table. Default: ""
+func (builder *TableBuilder) SetTooltip(text string) *TableBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithDataSource sets the source of the cells. Default: no rows
+func (builder *TableBuilder) WithDataSource(dataSource TableDataSource) *TableBuilder {
+	builder.dataSource = dataSource
+	return builder
+}
+
+// WithColumn adds a column with given title and initial width.
+// A nil comparator makes the column unsortable; NewTextComparator provides one that sorts by the cell texts.
+func (builder *TableBuilder) WithColumn(title string, width float32, comparator TableComparator) *TableBuilder {
+	builder.columns = append(builder.columns, TableColumn{Title: title, Width: width, Comparator: comparator})
+	return builder
+}
+
+// WithRowHeight sets the height of the header and each row. Default: 20
+func (builder *TableBuilder) WithRowHeight(value float32) *TableBuilder {
+	builder.rowHeight = value
+	return builder
+}
+
+// WithTableSelectionChangeHandler sets the handler for a change of the selected row.
+func (builder *TableBuilder) WithTableSelectionChangeHandler(handler TableSelectionChangeHandler) *TableBuilder {
+	builder.tableSelectionChangeHandler = handler
+	return builder
+}
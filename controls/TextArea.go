@@ -0,0 +1,602 @@
+package controls
+
+import (
+	"strings"
+
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// textAreaHistoryLimit is the maximum number of changes that can be undone.
+const textAreaHistoryLimit = 100
+
+// textAreaScrollLines is the number of lines scrolled per step of the mouse wheel.
+const textAreaScrollLines = 3
+
+// textLine is one visual line of a text area. It covers the characters from start up to, excluding, end.
+type textLine struct {
+	start int
+	end   int
+}
+
+// textAreaState is a snapshot of the editable state, as kept in the undo history.
+type textAreaState struct {
+	text            []rune
+	caretIndex      int
+	selectionAnchor int
+}
+
+// TextArea is a control for editing text spanning multiple lines.
+// Lines that exceed the width of the area are wrapped at word boundaries.
+type TextArea struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	clipboard    Clipboard
+
+	label       *Label
+	verticalBar *scrollBar
+
+	textChangeHandler TextChangeHandler
+
+	text            []rune
+	caretIndex      int
+	selectionAnchor int
+	selecting       bool
+	goalX           float32
+	scrollOffset    float32
+
+	lines     []textLine
+	wrapWidth float32
+
+	undoStack []textAreaState
+	redoStack []textAreaState
+	typing    bool
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (textArea *TextArea) Dispose() {
+	textArea.label.Dispose()
+	textArea.area.Remove()
+}
+
+// Text returns the current text.
+func (textArea *TextArea) Text() string {
+	return string(textArea.text)
+}
+
+// SetText updates the current text and clears the undo history. Does not fire change handler.
+func (textArea *TextArea) SetText(text string) {
+	textArea.text = []rune(strings.Replace(text, "\r", "", -1))
+	textArea.caretIndex = 0
+	textArea.selectionAnchor = 0
+	textArea.undoStack = nil
+	textArea.redoStack = nil
+	textArea.typing = false
+	textArea.goalX = -1
+	textArea.updateLabel()
+}
+
+// Undo reverts the most recent change. Fires the change handler if there was a change to revert.
+func (textArea *TextArea) Undo() {
+	if len(textArea.undoStack) > 0 {
+		textArea.redoStack = append(textArea.redoStack, textArea.snapshot())
+		textArea.restore(&textArea.undoStack, textArea.undoStack[len(textArea.undoStack)-1])
+	}
+}
+
+// Redo repeats the most recently undone change. Fires the change handler if there was a change to repeat.
+func (textArea *TextArea) Redo() {
+	if len(textArea.redoStack) > 0 {
+		textArea.undoStack = append(textArea.undoStack, textArea.snapshot())
+		textArea.restore(&textArea.redoStack, textArea.redoStack[len(textArea.redoStack)-1])
+	}
+}
+
+func (textArea *TextArea) snapshot() textAreaState {
+	return textAreaState{
+		text:            textArea.text,
+		caretIndex:      textArea.caretIndex,
+		selectionAnchor: textArea.selectionAnchor}
+}
+
+func (textArea *TextArea) restore(stack *[]textAreaState, state textAreaState) {
+	*stack = (*stack)[:len(*stack)-1]
+	textArea.text = state.text
+	textArea.caretIndex = state.caretIndex
+	textArea.selectionAnchor = state.selectionAnchor
+	textArea.typing = false
+	textArea.goalX = -1
+	textArea.updateLabel()
+	textArea.textChangeHandler(textArea.Text())
+}
+
+func (textArea *TextArea) onRender(area *area.Area) {
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+	lineHeight := textArea.lineHeight()
+
+	textArea.rectRenderer.Fill(areaLeft, areaTop, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.5))
+	if textArea.hasSelection() {
+		from, to := textArea.selectionRange()
+		for lineIndex, line := range textArea.lines {
+			segmentFrom, segmentTo := line.start, line.end
+			if from > segmentFrom {
+				segmentFrom = from
+			}
+			if to < segmentTo {
+				segmentTo = to
+			}
+			breakSelected := (from <= line.end) && (to > line.end) && textArea.endsParagraph(lineIndex)
+			if (segmentFrom < segmentTo) || breakSelected {
+				fromX := textArea.charPosition(lineIndex, segmentFrom)
+				toX := textArea.charPosition(lineIndex, segmentTo)
+				if breakSelected {
+					toX += 2 * textArea.label.scale
+				}
+				lineTop := textArea.linePosition(lineIndex)
+				textArea.fillClipped(textArea.clippedX(fromX), lineTop, textArea.clippedX(toX), lineTop+lineHeight,
+					graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+			}
+		}
+	}
+	if area.HasFocus() {
+		lineIndex := textArea.lineOf(textArea.caretIndex)
+		caretX := textArea.charPosition(lineIndex, textArea.caretIndex)
+		if (caretX >= textArea.label.area.Left().Value()) && (caretX < textArea.label.area.Right().Value()) {
+			lineTop := textArea.linePosition(lineIndex)
+			textArea.fillClipped(caretX, lineTop, caretX+1, lineTop+lineHeight, graphics.RGBA(1.0, 0.0, 0.34, 1.0))
+		}
+	}
+}
+
+func (textArea *TextArea) onBoundsChange(area *area.Area) {
+	width := textArea.label.area.Right().Value() - textArea.label.area.Left().Value()
+
+	if width != textArea.wrapWidth {
+		textArea.updateLabel()
+	} else {
+		textArea.scrollTo(textArea.scrollOffset)
+	}
+}
+
+func (textArea *TextArea) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !textArea.area.Contains(mouseEvent.Position()) {
+		if area.HasFocus() {
+			area.ReleaseFocus()
+		}
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		area.RequestFocus()
+		textArea.moveCaret(textArea.charIndexAt(mouseEvent.Position()), input.Modifier(mouseEvent.Modifier()).Has(input.ModShift))
+		textArea.selecting = true
+		consumed = true
+	}
+
+	return
+}
+
+func (textArea *TextArea) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if textArea.selecting && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		textArea.selecting = false
+		consumed = true
+	}
+
+	return
+}
+
+func (textArea *TextArea) onMouseMove(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseMoveEvent)
+
+	if textArea.selecting {
+		textArea.moveCaret(textArea.charIndexAt(mouseEvent.Position()), true)
+		consumed = true
+	}
+
+	return
+}
+
+func (textArea *TextArea) onMouseScroll(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseScrollEvent)
+
+	if textArea.area.Contains(mouseEvent.Position()) {
+		_, dy := mouseEvent.Deltas()
+		textArea.scrollTo(textArea.scrollOffset + dy*textArea.lineHeight()*textAreaScrollLines)
+		consumed = true
+	}
+
+	return
+}
+
+func (textArea *TextArea) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+	extend := keyEvent.Modifier().Has(input.ModShift)
+	caretLine := textArea.lineOf(textArea.caretIndex)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyLeft:
+		if textArea.hasSelection() && !extend {
+			from, _ := textArea.selectionRange()
+			textArea.moveCaret(from, false)
+		} else {
+			textArea.moveCaret(textArea.caretIndex-1, extend)
+		}
+	case input.KeyRight:
+		if textArea.hasSelection() && !extend {
+			_, to := textArea.selectionRange()
+			textArea.moveCaret(to, false)
+		} else {
+			textArea.moveCaret(textArea.caretIndex+1, extend)
+		}
+	case input.KeyUp:
+		textArea.moveCaretVertically(-1, extend)
+	case input.KeyDown:
+		textArea.moveCaretVertically(1, extend)
+	case input.KeyPageUp:
+		textArea.moveCaretVertically(-textArea.visibleLineCount(), extend)
+	case input.KeyPageDown:
+		textArea.moveCaretVertically(textArea.visibleLineCount(), extend)
+	case input.KeyHome:
+		textArea.moveCaret(textArea.lines[caretLine].start, extend)
+	case input.KeyEnd:
+		textArea.moveCaret(textArea.lineEnd(caretLine), extend)
+	case input.KeyEnter:
+		textArea.replaceSelection("\n", false)
+	case input.KeyBackspace:
+		if !textArea.hasSelection() {
+			textArea.selectionAnchor = textArea.caretIndex - 1
+		}
+		textArea.replaceSelection("", false)
+	case input.KeyDelete:
+		if !textArea.hasSelection() {
+			textArea.selectionAnchor = textArea.caretIndex + 1
+		}
+		textArea.replaceSelection("", false)
+	case input.KeyCopy:
+		textArea.copySelection()
+	case input.KeyCut:
+		textArea.copySelection()
+		textArea.replaceSelection("", false)
+	case input.KeyPaste:
+		textArea.paste()
+	case input.KeyUndo:
+		textArea.Undo()
+	case input.KeyRedo:
+		textArea.Redo()
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (textArea *TextArea) onChar(area *area.Area, event events.Event) (consumed bool) {
+	charEvent := event.(*events.CharEvent)
+	char := charEvent.Char()
+
+	if char >= ' ' {
+		textArea.replaceSelection(string(char), true)
+		consumed = true
+	}
+
+	return
+}
+
+func (textArea *TextArea) hasSelection() bool {
+	return textArea.selectionAnchor != textArea.caretIndex
+}
+
+func (textArea *TextArea) selectionRange() (from, to int) {
+	from, to = textArea.selectionAnchor, textArea.caretIndex
+	if from > to {
+		from, to = to, from
+	}
+	return
+}
+
+func (textArea *TextArea) limitedIndex(index int) int {
+	if index < 0 {
+		index = 0
+	} else if index > len(textArea.text) {
+		index = len(textArea.text)
+	}
+	return index
+}
+
+func (textArea *TextArea) moveCaret(index int, extendSelection bool) {
+	textArea.caretIndex = textArea.limitedIndex(index)
+	if !extendSelection {
+		textArea.selectionAnchor = textArea.caretIndex
+	}
+	textArea.typing = false
+	textArea.goalX = -1
+	textArea.scrollToCaret()
+}
+
+// moveCaretVertically moves the caret by given amount of visual lines, keeping the horizontal position
+// of the first vertical move for subsequent ones.
+func (textArea *TextArea) moveCaretVertically(delta int, extendSelection bool) {
+	caretLine := textArea.lineOf(textArea.caretIndex)
+	goalX := textArea.goalX
+	if goalX < 0 {
+		goalX = textArea.charPosition(caretLine, textArea.caretIndex) - textArea.label.area.Left().Value()
+	}
+	targetLine := caretLine + delta
+	index := textArea.caretIndex
+
+	if targetLine < 0 {
+		index = 0
+	} else if targetLine >= len(textArea.lines) {
+		index = len(textArea.text)
+	} else {
+		index = textArea.charIndexInLine(targetLine, textArea.label.area.Left().Value()+goalX)
+	}
+	textArea.moveCaret(index, extendSelection)
+	textArea.goalX = goalX
+}
+
+func (textArea *TextArea) replaceSelection(text string, typing bool) {
+	textArea.selectionAnchor = textArea.limitedIndex(textArea.selectionAnchor)
+	from, to := textArea.selectionRange()
+	inserted := []rune(text)
+	newText := make([]rune, 0, len(textArea.text)-(to-from)+len(inserted))
+
+	newText = append(newText, textArea.text[:from]...)
+	newText = append(newText, inserted...)
+	newText = append(newText, textArea.text[to:]...)
+	changed := string(newText) != string(textArea.text)
+	if changed {
+		if !typing || !textArea.typing {
+			textArea.pushUndo()
+		}
+		textArea.redoStack = nil
+	}
+	textArea.text = newText
+	textArea.caretIndex = from + len(inserted)
+	textArea.selectionAnchor = textArea.caretIndex
+	textArea.typing = typing
+	textArea.goalX = -1
+	textArea.updateLabel()
+	if changed {
+		textArea.textChangeHandler(textArea.Text())
+	}
+}
+
+func (textArea *TextArea) pushUndo() {
+	textArea.undoStack = append(textArea.undoStack, textArea.snapshot())
+	if len(textArea.undoStack) > textAreaHistoryLimit {
+		textArea.undoStack = textArea.undoStack[len(textArea.undoStack)-textAreaHistoryLimit:]
+	}
+}
+
+func (textArea *TextArea) copySelection() {
+	if textArea.hasSelection() {
+		from, to := textArea.selectionRange()
+		textArea.clipboard.SetClipboardString(string(textArea.text[from:to]))
+	}
+}
+
+func (textArea *TextArea) paste() {
+	value, err := textArea.clipboard.ClipboardString()
+
+	if err == nil {
+		textArea.replaceSelection(strings.Replace(value, "\r", "", -1), false)
+	}
+}
+
+// updateLabel wraps the text into visual lines and sets them as label text.
+func (textArea *TextArea) updateLabel() {
+	textArea.wrapWidth = textArea.label.area.Right().Value() - textArea.label.area.Left().Value()
+	textArea.lines = nil
+	paragraphStart := 0
+	for paragraphStart <= len(textArea.text) {
+		paragraphEnd := paragraphStart
+		for (paragraphEnd < len(textArea.text)) && (textArea.text[paragraphEnd] != '\n') {
+			paragraphEnd++
+		}
+		textArea.wrapParagraph(paragraphStart, paragraphEnd)
+		paragraphStart = paragraphEnd + 1
+	}
+
+	visualLines := make([]string, len(textArea.lines))
+	for lineIndex, line := range textArea.lines {
+		visualLines[lineIndex] = string(textArea.text[line.start:line.end])
+	}
+	textArea.label.SetText(strings.Join(visualLines, "\n"))
+	textArea.scrollToCaret()
+}
+
+// wrapParagraph adds the visual lines for the characters from start up to end, which contain no line break.
+// Lines are broken after a space if possible, and between characters for words exceeding the width.
+func (textArea *TextArea) wrapParagraph(start, end int) {
+	bitmap := textArea.label.textPainter.Paint(string(textArea.text[start:end]))
+	lineStart := start
+	lastBreak := -1
+
+	for index := start; index < end; index++ {
+		if textArea.text[index] == ' ' {
+			lastBreak = index + 1
+		} else {
+			lineWidth := float32(2+bitmap.CharOffset(0, index+1-start)-bitmap.CharOffset(0, lineStart-start)) * textArea.label.scale
+			if (lineWidth > textArea.wrapWidth) && (index > lineStart) {
+				breakIndex := index
+				if lastBreak > lineStart {
+					breakIndex = lastBreak
+				}
+				textArea.lines = append(textArea.lines, textLine{start: lineStart, end: breakIndex})
+				lineStart = breakIndex
+				lastBreak = -1
+			}
+		}
+	}
+	textArea.lines = append(textArea.lines, textLine{start: lineStart, end: end})
+}
+
+// lineOf returns the visual line the given character is displayed in.
+// An index at the end of a wrapped line belongs to the start of the following line.
+func (textArea *TextArea) lineOf(index int) int {
+	for lineIndex := len(textArea.lines) - 1; lineIndex > 0; lineIndex-- {
+		if textArea.lines[lineIndex].start <= index {
+			return lineIndex
+		}
+	}
+	return 0
+}
+
+// endsParagraph returns true if the given visual line is followed by a line break.
+func (textArea *TextArea) endsParagraph(lineIndex int) bool {
+	return (lineIndex+1 < len(textArea.lines)) && (textArea.lines[lineIndex+1].start > textArea.lines[lineIndex].end)
+}
+
+// lineEnd returns the last caret index that is displayed within the given visual line.
+func (textArea *TextArea) lineEnd(lineIndex int) int {
+	line := textArea.lines[lineIndex]
+	end := line.end
+
+	if (lineIndex+1 < len(textArea.lines)) && !textArea.endsParagraph(lineIndex) && (end > line.start) {
+		end--
+	}
+	return end
+}
+
+func (textArea *TextArea) lineHeight() float32 {
+	return float32(textArea.label.bitmap.LineHeight()) * textArea.label.scale
+}
+
+func (textArea *TextArea) contentHeight() float32 {
+	return float32(len(textArea.lines))*textArea.lineHeight() + textArea.label.scale
+}
+
+func (textArea *TextArea) visibleHeight() float32 {
+	return textArea.label.area.Bottom().Value() - textArea.label.area.Top().Value()
+}
+
+func (textArea *TextArea) visibleLineCount() int {
+	count := int(textArea.visibleHeight() / textArea.lineHeight())
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+// lineOffset returns the vertical offset of the given visual line, relative to the start of the text, in area units.
+func (textArea *TextArea) lineOffset(lineIndex int) float32 {
+	return textArea.label.scale + float32(lineIndex)*textArea.lineHeight()
+}
+
+// linePosition returns the vertical position of the given visual line, in area coordinates.
+func (textArea *TextArea) linePosition(lineIndex int) float32 {
+	return textArea.label.area.Top().Value() + textArea.lineOffset(lineIndex) - textArea.scrollOffset
+}
+
+// charPosition returns the horizontal position of the given character within the given visual line, in area coordinates.
+func (textArea *TextArea) charPosition(lineIndex int, index int) float32 {
+	offset := textArea.label.bitmap.CharOffset(lineIndex, index-textArea.lines[lineIndex].start)
+	return textArea.label.area.Left().Value() + float32(1+offset)*textArea.label.scale
+}
+
+func (textArea *TextArea) clippedX(x float32) float32 {
+	left := textArea.label.area.Left().Value()
+	right := textArea.label.area.Right().Value()
+
+	if x < left {
+		x = left
+	} else if x > right {
+		x = right
+	}
+	return x
+}
+
+// fillClipped fills the given rectangle, limited vertically to the label area.
+func (textArea *TextArea) fillClipped(left, top, right, bottom float32, color graphics.Color) {
+	labelTop := textArea.label.area.Top().Value()
+	labelBottom := textArea.label.area.Bottom().Value()
+
+	if top < labelTop {
+		top = labelTop
+	}
+	if bottom > labelBottom {
+		bottom = labelBottom
+	}
+	if top < bottom {
+		textArea.rectRenderer.Fill(left, top, right, bottom, color)
+	}
+}
+
+func (textArea *TextArea) charIndexAt(x, y float32) int {
+	lineIndex := int((y - textArea.linePosition(0)) / textArea.lineHeight())
+
+	if lineIndex < 0 {
+		lineIndex = 0
+	} else if lineIndex >= len(textArea.lines) {
+		lineIndex = len(textArea.lines) - 1
+	}
+	return textArea.charIndexInLine(lineIndex, x)
+}
+
+func (textArea *TextArea) charIndexInLine(lineIndex int, x float32) int {
+	line := textArea.lines[lineIndex]
+	result := line.start
+	bestDistance := float32(-1.0)
+
+	for index := line.start; index <= textArea.lineEnd(lineIndex); index++ {
+		distance := textArea.charPosition(lineIndex, index) - x
+		if distance < 0 {
+			distance = -distance
+		}
+		if (bestDistance < 0) || (distance < bestDistance) {
+			result = index
+			bestDistance = distance
+		}
+	}
+
+	return result
+}
+
+func (textArea *TextArea) scrollToCaret() {
+	lineIndex := textArea.lineOf(textArea.caretIndex)
+	lineTop := textArea.lineOffset(lineIndex)
+	lineBottom := lineTop + textArea.lineHeight()
+	visibleHeight := textArea.visibleHeight()
+	offset := textArea.scrollOffset
+
+	if lineBottom-offset > visibleHeight {
+		offset = lineBottom - visibleHeight
+	}
+	if lineTop < offset {
+		offset = lineTop - textArea.label.scale
+	}
+	textArea.scrollTo(offset)
+}
+
+// scrollTo moves the visible part to given vertical offset within the text.
+// The offset is limited to the extent of the text.
+func (textArea *TextArea) scrollTo(offset float32) {
+	maxOffset := textArea.contentHeight() - textArea.visibleHeight()
+
+	if offset > maxOffset {
+		offset = maxOffset
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	textArea.scrollOffset = offset
+	if textArea.verticalBar != nil {
+		textArea.verticalBar.update()
+	}
+}
+
+func (textArea *TextArea) verticalMetrics() (position, visible, total float32) {
+	return textArea.scrollOffset, textArea.visibleHeight(), textArea.contentHeight()
+}
+
+func (textArea *TextArea) alignText(containerSize float32, elementSize float32) float32 {
+	return -textArea.scrollOffset
+}
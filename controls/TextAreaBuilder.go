@@ -0,0 +1,132 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// TextAreaBuilder is a builder for TextArea instances.
+type TextAreaBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+	clipboard    Clipboard
+
+	textChangeHandler TextChangeHandler
+
+	text string
+}
+
+// NewTextAreaBuilder returns a new TextAreaBuilder instance.
+// If no clipboard is provided, the text areas share a clipboard local to the application.
+func NewTextAreaBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer, clipboard Clipboard) *TextAreaBuilder {
+	if clipboard == nil {
+		clipboard = &localClipboard{}
+	}
+	builder := &TextAreaBuilder{
+		areaBuilder:       area.NewAreaBuilder(),
+		rectRenderer:      rectRenderer,
+		labelBuilder:      labelBuilder,
+		clipboard:         clipboard,
+		textChangeHandler: func(string) {}}
+
+	return builder
+}
+
+// Build creates a new TextArea instance from the current parameters.
+func (builder *TextAreaBuilder) Build() *TextArea {
+	textArea := &TextArea{
+		rectRenderer:      builder.rectRenderer,
+		clipboard:         builder.clipboard,
+		textChangeHandler: builder.textChangeHandler,
+		goalX:             -1}
+
+	builder.areaBuilder.OnRender(textArea.onRender)
+	builder.areaBuilder.OnBoundsChange(textArea.onBoundsChange)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, textArea.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, textArea.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseMoveEventType, textArea.onMouseMove)
+	builder.areaBuilder.OnEvent(events.MouseScrollEventType, textArea.onMouseScroll)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, textArea.onKey)
+	builder.areaBuilder.OnEvent(events.CharEventType, textArea.onChar)
+	builder.areaBuilder.SetFocusable(true)
+	textArea.area = builder.areaBuilder.Build()
+
+	builder.labelBuilder.SetParent(textArea.area)
+	builder.labelBuilder.SetLeft(area.NewOffsetAnchor(textArea.area.Left(), 2))
+	builder.labelBuilder.SetTop(area.NewOffsetAnchor(textArea.area.Top(), 2))
+	builder.labelBuilder.SetRight(area.NewOffsetAnchor(textArea.area.Right(), -scrollBarThickness-2))
+	builder.labelBuilder.SetBottom(area.NewOffsetAnchor(textArea.area.Bottom(), -2))
+	builder.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	builder.labelBuilder.AlignedVerticallyBy(textArea.alignText)
+
+	textArea.label = builder.labelBuilder.Build()
+	textArea.SetText(builder.text)
+
+	verticalBuilder := area.NewAreaBuilder()
+	verticalBuilder.SetParent(textArea.area)
+	verticalBuilder.SetLeft(area.NewOffsetAnchor(textArea.area.Right(), -scrollBarThickness))
+	verticalBuilder.SetTop(textArea.area.Top())
+	verticalBuilder.SetRight(textArea.area.Right())
+	verticalBuilder.SetBottom(textArea.area.Bottom())
+	textArea.verticalBar = newScrollBar(verticalBuilder, builder.rectRenderer, false, textArea.verticalMetrics,
+		textArea.scrollTo)
+
+	return textArea
+}
+
+// SetParent sets the parent area.
+func (builder *TextAreaBuilder) SetParent(parent *area.Area) *TextAreaBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *TextAreaBuilder) SetLeft(value area.Anchor) *TextAreaBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *TextAreaBuilder) SetTop(value area.Anchor) *TextAreaBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *TextAreaBuilder) SetRight(value area.Anchor) *TextAreaBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *TextAreaBuilder) SetBottom(value area.Anchor) *TextAreaBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *TextAreaBuilder) SetTabIndex(value int) *TextAreaBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the text area. Default: ""
+func (builder *TextAreaBuilder) SetTooltip(text string) *TextAreaBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithText sets the initial text.
+func (builder *TextAreaBuilder) WithText(value string) *TextAreaBuilder {
+	builder.text = value
+	return builder
+}
+
+// WithTextChangeHandler sets the handler for a text change.
+func (builder *TextAreaBuilder) WithTextChangeHandler(handler TextChangeHandler) *TextAreaBuilder {
+	builder.textChangeHandler = handler
+	return builder
+}
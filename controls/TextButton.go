@@ -1,12 +1,18 @@
 package controls
 
 import (
+	"time"
+
+	"github.com/dertseha/jellui/animation"
 	"github.com/dertseha/jellui/area"
 	"github.com/dertseha/jellui/area/events"
 	"github.com/dertseha/jellui/graphics"
 	"github.com/dertseha/jellui/input"
 )
 
+// textButtonTransition is the duration of animated changes of the color and the label offset.
+const textButtonTransition = 100 * time.Millisecond
+
 // ActionHandler is the callback for a firing button (actionable).
 type ActionHandler func()
 
@@ -22,10 +28,15 @@ type TextButton struct {
 	actionHandler ActionHandler
 
 	idleColor     graphics.Color
+	hoverColor    graphics.Color
 	preparedColor graphics.Color
 
 	prepared bool
+	hovered  bool
 	color    graphics.Color
+
+	animator   *animation.Animator
+	colorTween *animation.Tween
 }
 
 // Dispose releases all resources.
@@ -41,7 +52,10 @@ func (button *TextButton) onRender(area *area.Area) {
 func (button *TextButton) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
 	mouseEvent := event.(*events.MouseButtonEvent)
 
-	if mouseEvent.Buttons() == input.MousePrimary {
+	if !button.area.Contains(mouseEvent.Position()) {
+		button.unprepare()
+		area.ReleaseFocus()
+	} else if mouseEvent.Buttons() == input.MousePrimary {
 		area.RequestFocus()
 		button.prepare()
 		consumed = true
@@ -56,7 +70,7 @@ func (button *TextButton) onMouseUp(area *area.Area, event events.Event) (consum
 	if button.area.HasFocus() && mouseEvent.AffectedButtons() == input.MousePrimary {
 		area.ReleaseFocus()
 		button.unprepare()
-		if button.contains(mouseEvent) {
+		if button.area.Contains(mouseEvent.Position()) {
 			button.callHandler()
 		}
 		consumed = true
@@ -65,29 +79,80 @@ func (button *TextButton) onMouseUp(area *area.Area, event events.Event) (consum
 	return
 }
 
+func (button *TextButton) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+
+	if keyEvent.Key() == input.KeyEnter {
+		button.callHandler()
+		consumed = true
+	}
+
+	return
+}
+
+func (button *TextButton) onChar(area *area.Area, event events.Event) (consumed bool) {
+	charEvent := event.(*events.CharEvent)
+
+	if charEvent.Char() == ' ' {
+		button.callHandler()
+		consumed = true
+	}
+
+	return
+}
+
+func (button *TextButton) onMouseEnter(area *area.Area, event events.Event) bool {
+	button.hovered = true
+	button.updateColor()
+	return true
+}
+
+func (button *TextButton) onMouseLeave(area *area.Area, event events.Event) bool {
+	button.hovered = false
+	button.updateColor()
+	return true
+}
+
 func (button *TextButton) prepare() {
 	if !button.prepared {
-		button.color = button.preparedColor
-		button.labelLeft.RequestValue(button.labelLeft.Value() + 5)
-		button.labelTop.RequestValue(button.labelTop.Value() + 2)
+		button.labelLeft.RequestValue(button.area.Left().Value() + 5)
+		button.labelTop.RequestValue(button.area.Top().Value() + 2)
 		button.prepared = true
+		button.updateColor()
 	}
 }
 
 func (button *TextButton) unprepare() {
 	if button.prepared {
-		button.color = button.idleColor
-		button.labelLeft.RequestValue(button.labelLeft.Value() - 5)
-		button.labelTop.RequestValue(button.labelTop.Value() - 2)
+		button.labelLeft.RequestValue(button.area.Left().Value())
+		button.labelTop.RequestValue(button.area.Top().Value())
 		button.prepared = false
+		button.updateColor()
 	}
 }
 
-func (button *TextButton) contains(event events.PositionalEvent) bool {
-	x, y := event.Position()
+func (button *TextButton) updateColor() {
+	var newColor graphics.Color
 
-	return (x >= button.area.Left().Value()) && (x < button.area.Right().Value()) &&
-		(y >= button.area.Top().Value()) && (y < button.area.Bottom().Value())
+	if button.prepared {
+		newColor = button.preparedColor
+	} else if button.hovered {
+		newColor = button.hoverColor
+	} else {
+		newColor = button.idleColor
+	}
+	if button.colorTween != nil {
+		button.colorTween.Stop()
+		button.colorTween = nil
+	}
+	if button.animator != nil {
+		from := button.color.AsVector()
+		to := newColor.AsVector()
+		button.colorTween = button.animator.Tween(from[:], to[:], textButtonTransition, animation.EaseOutQuad,
+			func(values []float32) { button.color = graphics.RGBA(values[0], values[1], values[2], values[3]) })
+	} else {
+		button.color = newColor
+	}
 }
 
 func (button *TextButton) callHandler() {
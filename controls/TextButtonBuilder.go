@@ -1,6 +1,7 @@
 package controls
 
 import (
+	"github.com/dertseha/jellui/animation"
 	"github.com/dertseha/jellui/area"
 	"github.com/dertseha/jellui/area/events"
 	"github.com/dertseha/jellui/graphics"
@@ -12,12 +13,14 @@ type TextButtonBuilder struct {
 	rectRenderer *graphics.RectangleRenderer
 
 	idleColor     graphics.Color
+	hoverColor    graphics.Color
 	preparedColor graphics.Color
 
 	labelBuilder *LabelBuilder
 	text         string
 
 	actionHandler ActionHandler
+	animator      *animation.Animator
 }
 
 // NewTextButtonBuilder returns a new TextButtonBuilder instance.
@@ -26,6 +29,7 @@ func NewTextButtonBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.Rec
 		areaBuilder:   area.NewAreaBuilder(),
 		rectRenderer:  rectRenderer,
 		idleColor:     graphics.RGBA(0.31, 0.56, 0.34, 0.8),
+		hoverColor:    graphics.RGBA(0.36, 0.62, 0.39, 0.85),
 		preparedColor: graphics.RGBA(0.31, 0.56, 0.34, 0.95),
 		labelBuilder:  labelBuilder,
 		text:          "",
@@ -39,17 +43,28 @@ func (builder *TextButtonBuilder) Build() *TextButton {
 	button := &TextButton{
 		rectRenderer:  builder.rectRenderer,
 		idleColor:     builder.idleColor,
+		hoverColor:    builder.hoverColor,
 		preparedColor: builder.preparedColor,
 		color:         builder.idleColor,
-		actionHandler: builder.actionHandler}
+		actionHandler: builder.actionHandler,
+		animator:      builder.animator}
 
 	builder.areaBuilder.OnRender(button.onRender)
 	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, button.onMouseDown)
 	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, button.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseEnterEventType, button.onMouseEnter)
+	builder.areaBuilder.OnEvent(events.MouseLeaveEventType, button.onMouseLeave)
+	builder.areaBuilder.OnEvent(events.KeyEventType, button.onKey)
+	builder.areaBuilder.OnEvent(events.CharEventType, button.onChar)
+	builder.areaBuilder.SetFocusable(true)
 	button.area = builder.areaBuilder.Build()
 
 	button.labelLeft = area.NewOffsetAnchor(button.area.Left(), 0)
 	button.labelTop = area.NewOffsetAnchor(button.area.Top(), 0)
+	if builder.animator != nil {
+		button.labelLeft = area.NewAnimatedAnchor(button.labelLeft, builder.animator, textButtonTransition, animation.EaseOutQuad)
+		button.labelTop = area.NewAnimatedAnchor(button.labelTop, builder.animator, textButtonTransition, animation.EaseOutQuad)
+	}
 
 	builder.labelBuilder.SetParent(button.area)
 	builder.labelBuilder.SetLeft(button.labelLeft)
@@ -93,6 +108,18 @@ func (builder *TextButtonBuilder) SetBottom(value area.Anchor) *TextButtonBuilde
 	return builder
 }
 
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *TextButtonBuilder) SetTabIndex(value int) *TextButtonBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the button. Default: ""
+func (builder *TextButtonBuilder) SetTooltip(text string) *TextButtonBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
 // WithText sets the label text to be used for the new button.
 func (builder *TextButtonBuilder) WithText(value string) *TextButtonBuilder {
 	builder.text = value
@@ -111,8 +138,22 @@ func (builder *TextButtonBuilder) WithIdleColor(color graphics.Color) *TextButto
 	return builder
 }
 
+// WithHoverColor sets the background color while the cursor is over the button.
+func (builder *TextButtonBuilder) WithHoverColor(color graphics.Color) *TextButtonBuilder {
+	builder.hoverColor = color
+	return builder
+}
+
 // WithPreparedColor sets the background color for the prepared state.
 func (builder *TextButtonBuilder) WithPreparedColor(color graphics.Color) *TextButtonBuilder {
 	builder.preparedColor = color
 	return builder
 }
+
+// WithAnimator sets the animator used to transition between the background colors,
+// and to move the label while the button is pressed.
+// Without an animator, the colors and the label change instantly. Default: nil
+func (builder *TextButtonBuilder) WithAnimator(animator *animation.Animator) *TextButtonBuilder {
+	builder.animator = animator
+	return builder
+}
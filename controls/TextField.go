@@ -0,0 +1,303 @@
+package controls
+
+import (
+	"strings"
+
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// TextChangeHandler is a callback for notifying the current text.
+type TextChangeHandler func(text string)
+
+// TextField is a control for entering a single line of text.
+type TextField struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	clipboard    Clipboard
+
+	label *Label
+
+	textChangeHandler TextChangeHandler
+
+	text            []rune
+	caretIndex      int
+	selectionAnchor int
+	selecting       bool
+	scrollOffset    float32
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (field *TextField) Dispose() {
+	field.label.Dispose()
+	field.area.Remove()
+}
+
+// Text returns the current text.
+func (field *TextField) Text() string {
+	return string(field.text)
+}
+
+// SetText updates the current text. Does not fire change handler.
+func (field *TextField) SetText(text string) {
+	field.text = []rune(text)
+	field.caretIndex = len(field.text)
+	field.selectionAnchor = field.caretIndex
+	field.updateLabel()
+}
+
+func (field *TextField) onRender(area *area.Area) {
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+
+	field.rectRenderer.Fill(areaLeft, areaTop, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.5))
+	if field.hasSelection() {
+		from, to := field.selectionRange()
+		fromX := field.clippedX(field.charPosition(from))
+		toX := field.clippedX(field.charPosition(to))
+		field.rectRenderer.Fill(fromX, areaTop+2, toX, areaBottom-2, graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+	}
+	if area.HasFocus() {
+		caretX := field.charPosition(field.caretIndex)
+		if (caretX >= field.label.area.Left().Value()) && (caretX < field.label.area.Right().Value()) {
+			field.rectRenderer.Fill(caretX, areaTop+2, caretX+1, areaBottom-2, graphics.RGBA(1.0, 0.0, 0.34, 1.0))
+		}
+	}
+}
+
+func (field *TextField) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !field.area.Contains(mouseEvent.Position()) {
+		if area.HasFocus() {
+			area.ReleaseFocus()
+		}
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		mouseX, _ := mouseEvent.Position()
+		area.RequestFocus()
+		field.moveCaret(field.charIndexAt(mouseX), input.Modifier(mouseEvent.Modifier()).Has(input.ModShift))
+		field.selecting = true
+		consumed = true
+	}
+
+	return
+}
+
+func (field *TextField) onMouseUp(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if field.selecting && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		field.selecting = false
+		consumed = true
+	}
+
+	return
+}
+
+func (field *TextField) onMouseMove(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseMoveEvent)
+
+	if field.selecting {
+		mouseX, _ := mouseEvent.Position()
+		field.moveCaret(field.charIndexAt(mouseX), true)
+		consumed = true
+	}
+
+	return
+}
+
+func (field *TextField) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+	extend := keyEvent.Modifier().Has(input.ModShift)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyLeft:
+		if field.hasSelection() && !extend {
+			from, _ := field.selectionRange()
+			field.moveCaret(from, false)
+		} else {
+			field.moveCaret(field.caretIndex-1, extend)
+		}
+	case input.KeyRight:
+		if field.hasSelection() && !extend {
+			_, to := field.selectionRange()
+			field.moveCaret(to, false)
+		} else {
+			field.moveCaret(field.caretIndex+1, extend)
+		}
+	case input.KeyHome:
+		field.moveCaret(0, extend)
+	case input.KeyEnd:
+		field.moveCaret(len(field.text), extend)
+	case input.KeyBackspace:
+		if !field.hasSelection() {
+			field.selectionAnchor = field.caretIndex - 1
+		}
+		field.replaceSelection("")
+	case input.KeyDelete:
+		if !field.hasSelection() {
+			field.selectionAnchor = field.caretIndex + 1
+		}
+		field.replaceSelection("")
+	case input.KeyCopy:
+		field.copySelection()
+	case input.KeyCut:
+		field.copySelection()
+		field.replaceSelection("")
+	case input.KeyPaste:
+		field.paste()
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+func (field *TextField) onChar(area *area.Area, event events.Event) (consumed bool) {
+	charEvent := event.(*events.CharEvent)
+	char := charEvent.Char()
+
+	if char >= ' ' {
+		field.replaceSelection(string(char))
+		consumed = true
+	}
+
+	return
+}
+
+func (field *TextField) hasSelection() bool {
+	return field.selectionAnchor != field.caretIndex
+}
+
+func (field *TextField) selectionRange() (from, to int) {
+	from, to = field.selectionAnchor, field.caretIndex
+	if from > to {
+		from, to = to, from
+	}
+	return
+}
+
+func (field *TextField) limitedIndex(index int) int {
+	if index < 0 {
+		index = 0
+	} else if index > len(field.text) {
+		index = len(field.text)
+	}
+	return index
+}
+
+func (field *TextField) moveCaret(index int, extendSelection bool) {
+	field.caretIndex = field.limitedIndex(index)
+	if !extendSelection {
+		field.selectionAnchor = field.caretIndex
+	}
+	field.scrollToCaret()
+}
+
+func (field *TextField) replaceSelection(text string) {
+	field.selectionAnchor = field.limitedIndex(field.selectionAnchor)
+	from, to := field.selectionRange()
+	inserted := []rune(text)
+	newText := make([]rune, 0, len(field.text)-(to-from)+len(inserted))
+
+	newText = append(newText, field.text[:from]...)
+	newText = append(newText, inserted...)
+	newText = append(newText, field.text[to:]...)
+	changed := string(newText) != string(field.text)
+	field.text = newText
+	field.caretIndex = from + len(inserted)
+	field.selectionAnchor = field.caretIndex
+	field.updateLabel()
+	if changed {
+		field.textChangeHandler(field.Text())
+	}
+}
+
+func (field *TextField) copySelection() {
+	if field.hasSelection() {
+		from, to := field.selectionRange()
+		field.clipboard.SetClipboardString(string(field.text[from:to]))
+	}
+}
+
+func (field *TextField) paste() {
+	value, err := field.clipboard.ClipboardString()
+
+	if err == nil {
+		value = strings.Replace(value, "\r", "", -1)
+		value = strings.Replace(value, "\n", " ", -1)
+		field.replaceSelection(value)
+	}
+}
+
+func (field *TextField) updateLabel() {
+	field.label.SetText(field.Text())
+	field.scrollToCaret()
+}
+
+func (field *TextField) scrollToCaret() {
+	visibleWidth := field.label.area.Right().Value() - field.label.area.Left().Value()
+	caretOffset := field.charOffset(field.caretIndex)
+	maxOffset := field.charOffset(len(field.text)) - visibleWidth + 1
+
+	if caretOffset-field.scrollOffset >= visibleWidth {
+		field.scrollOffset = caretOffset - visibleWidth + 1
+	} else if caretOffset < field.scrollOffset {
+		field.scrollOffset = caretOffset
+	}
+	if field.scrollOffset > maxOffset {
+		field.scrollOffset = maxOffset
+	}
+	if field.scrollOffset < 0 {
+		field.scrollOffset = 0
+	}
+}
+
+func (field *TextField) alignText(containerSize float32, elementSize float32) float32 {
+	return -field.scrollOffset
+}
+
+// charOffset returns the offset of the given character, relative to the start of the text, in area units.
+func (field *TextField) charOffset(index int) float32 {
+	return float32(1+field.label.bitmap.CharOffset(0, index)) * field.label.scale
+}
+
+// charPosition returns the horizontal position of the given character, in area coordinates.
+func (field *TextField) charPosition(index int) float32 {
+	return field.label.area.Left().Value() + field.charOffset(index) - field.scrollOffset
+}
+
+func (field *TextField) clippedX(x float32) float32 {
+	left := field.label.area.Left().Value()
+	right := field.label.area.Right().Value()
+
+	if x < left {
+		x = left
+	} else if x > right {
+		x = right
+	}
+	return x
+}
+
+func (field *TextField) charIndexAt(x float32) int {
+	result := 0
+	bestDistance := float32(-1.0)
+
+	for index := 0; index <= len(field.text); index++ {
+		distance := field.charPosition(index) - x
+		if distance < 0 {
+			distance = -distance
+		}
+		if (bestDistance < 0) || (distance < bestDistance) {
+			result = index
+			bestDistance = distance
+		}
+	}
+
+	return result
+}
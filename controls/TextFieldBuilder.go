@@ -0,0 +1,119 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+// TextFieldBuilder is a builder for TextField instances.
+type TextFieldBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+	clipboard    Clipboard
+
+	textChangeHandler TextChangeHandler
+
+	text string
+}
+
+// NewTextFieldBuilder returns a new TextFieldBuilder instance.
+// If no clipboard is provided, the text fields share a clipboard local to the application.
+func NewTextFieldBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer, clipboard Clipboard) *TextFieldBuilder {
+	if clipboard == nil {
+		clipboard = &localClipboard{}
+	}
+	builder := &TextFieldBuilder{
+		areaBuilder:       area.NewAreaBuilder(),
+		rectRenderer:      rectRenderer,
+		labelBuilder:      labelBuilder,
+		clipboard:         clipboard,
+		textChangeHandler: func(string) {}}
+
+	return builder
+}
+
+// Build creates a new TextField instance from the current parameters.
+func (builder *TextFieldBuilder) Build() *TextField {
+	field := &TextField{
+		rectRenderer:      builder.rectRenderer,
+		clipboard:         builder.clipboard,
+		textChangeHandler: builder.textChangeHandler}
+
+	builder.areaBuilder.OnRender(field.onRender)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, field.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonUpEventType, field.onMouseUp)
+	builder.areaBuilder.OnEvent(events.MouseMoveEventType, field.onMouseMove)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, field.onKey)
+	builder.areaBuilder.OnEvent(events.CharEventType, field.onChar)
+	builder.areaBuilder.SetFocusable(true)
+	field.area = builder.areaBuilder.Build()
+
+	builder.labelBuilder.SetParent(field.area)
+	builder.labelBuilder.SetLeft(area.NewOffsetAnchor(field.area.Left(), 2))
+	builder.labelBuilder.SetTop(area.NewOffsetAnchor(field.area.Top(), 0))
+	builder.labelBuilder.SetRight(area.NewOffsetAnchor(field.area.Right(), -2))
+	builder.labelBuilder.SetBottom(area.NewOffsetAnchor(field.area.Bottom(), 0))
+	builder.labelBuilder.AlignedHorizontallyBy(field.alignText)
+
+	field.label = builder.labelBuilder.Build()
+	field.SetText(builder.text)
+
+	return field
+}
+
+// SetParent sets the parent area.
+func (builder *TextFieldBuilder) SetParent(parent *area.Area) *TextFieldBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *TextFieldBuilder) SetLeft(value area.Anchor) *TextFieldBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *TextFieldBuilder) SetTop(value area.Anchor) *TextFieldBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *TextFieldBuilder) SetRight(value area.Anchor) *TextFieldBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *TextFieldBuilder) SetBottom(value area.Anchor) *TextFieldBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *TextFieldBuilder) SetTabIndex(value int) *TextFieldBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the field. Default: ""
+func (builder *TextFieldBuilder) SetTooltip(text string) *TextFieldBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithText sets the initial text.
+func (builder *TextFieldBuilder) WithText(value string) *TextFieldBuilder {
+	builder.text = value
+	return builder
+}
+
+// WithTextChangeHandler sets the handler for a text change.
+func (builder *TextFieldBuilder) WithTextChangeHandler(handler TextChangeHandler) *TextFieldBuilder {
+	builder.textChangeHandler = handler
+	return builder
+}
@@ -0,0 +1,172 @@
+package controls
+
+import (
+	"time"
+
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+const (
+	tooltipZIndex        = 1 << 20
+	tooltipPadding       = float32(4.0)
+	tooltipCursorOffsetX = float32(12.0)
+	tooltipCursorOffsetY = float32(20.0)
+)
+
+// Tooltips shows the tooltip text of the area below the cursor, once the cursor rested on it
+// for the hover delay. The tooltip is placed next to the cursor, within the bounds of the root area.
+// It is placed to the left of, or above, the cursor if there is not enough room to the right, or below.
+// It is hidden when the cursor leaves the area, when a mouse button is pressed,
+// or when the area, or one of its ancestors, becomes invisible.
+type Tooltips struct {
+	root         *area.Area
+	dispatcher   *area.Dispatcher
+	labelBuilder *LabelBuilder
+	rectRenderer *graphics.RectangleRenderer
+
+	delay time.Duration
+
+	owner      *area.Area
+	text       string
+	hoverTime  time.Duration
+	suppressed bool
+	x, y       float32
+
+	tooltipArea *area.Area
+	label       *Label
+}
+
+// NewTooltips returns a new Tooltips instance for the areas below the given root.
+// The hovered areas are determined by the dispatcher.
+func NewTooltips(root *area.Area, dispatcher *area.Dispatcher, labelBuilder *LabelBuilder,
+	rectRenderer *graphics.RectangleRenderer) *Tooltips {
+	return &Tooltips{
+		root:         root,
+		dispatcher:   dispatcher,
+		labelBuilder: labelBuilder,
+		rectRenderer: rectRenderer,
+		delay:        500 * time.Millisecond}
+}
+
+// SetDelay sets the time the cursor has to rest on an area before its tooltip is shown. Default: 500ms
+func (tooltips *Tooltips) SetDelay(value time.Duration) {
+	tooltips.delay = value
+}
+
+// HandlePositionalEvent updates the tooltip for an event that was dispatched to the root area.
+func (tooltips *Tooltips) HandlePositionalEvent(event events.PositionalEvent) {
+	tooltips.x, tooltips.y = event.Position()
+
+	switch event.EventType() {
+	case events.MouseMoveEventType:
+		owner, text := tooltips.dispatcher.HoveredTooltip()
+		if tooltips.isCursorOnTooltip() {
+			owner, text = tooltips.owner, tooltips.text
+		}
+		if (owner != tooltips.owner) || (text != tooltips.text) {
+			tooltips.hide()
+			tooltips.owner, tooltips.text = owner, text
+			tooltips.hoverTime = 0
+			tooltips.suppressed = false
+		}
+	case events.MouseButtonDownEventType, events.MouseScrollEventType:
+		tooltips.hide()
+		tooltips.suppressed = true
+	}
+}
+
+// Update advances the hover time by given duration and shows the tooltip when the delay passed.
+func (tooltips *Tooltips) Update(elapsed time.Duration) {
+	if (tooltips.owner != nil) && ((tooltips.owner.Root() != tooltips.root) || !tooltips.owner.IsShown()) {
+		tooltips.hide()
+		tooltips.owner = nil
+	}
+	if (tooltips.owner != nil) && (tooltips.tooltipArea == nil) && !tooltips.suppressed {
+		tooltips.hoverTime += elapsed
+		if tooltips.hoverTime >= tooltips.delay {
+			tooltips.show()
+		}
+	}
+}
+
+func (tooltips *Tooltips) show() {
+	left := area.NewAbsoluteAnchor(0.0)
+	top := area.NewAbsoluteAnchor(0.0)
+	right := area.NewAbsoluteAnchor(0.0)
+	bottom := area.NewAbsoluteAnchor(0.0)
+	areaBuilder := area.NewAreaBuilder()
+
+	areaBuilder.SetParent(tooltips.root)
+	areaBuilder.SetLeft(left)
+	areaBuilder.SetTop(top)
+	areaBuilder.SetRight(right)
+	areaBuilder.SetBottom(bottom)
+	areaBuilder.SetZIndex(tooltipZIndex)
+	areaBuilder.OnRender(tooltips.onRender)
+	tooltips.tooltipArea = areaBuilder.Build()
+
+	tooltips.labelBuilder.SetParent(tooltips.tooltipArea)
+	tooltips.labelBuilder.SetLeft(area.NewOffsetAnchor(left, tooltipPadding))
+	tooltips.labelBuilder.SetTop(area.NewOffsetAnchor(top, tooltipPadding))
+	tooltips.labelBuilder.SetRight(area.NewOffsetAnchor(right, -tooltipPadding))
+	tooltips.labelBuilder.SetBottom(area.NewOffsetAnchor(bottom, -tooltipPadding))
+	tooltips.label = tooltips.labelBuilder.Build()
+	tooltips.label.SetText(tooltips.text)
+
+	textWidth, textHeight := tooltips.label.TextSize()
+	width := textWidth + 2*tooltipPadding
+	height := textHeight + 2*tooltipPadding
+	rootLeft, rootTop := tooltips.root.Left().Value(), tooltips.root.Top().Value()
+	rootRight, rootBottom := tooltips.root.Right().Value(), tooltips.root.Bottom().Value()
+	tooltipLeft := tooltips.x + tooltipCursorOffsetX
+	tooltipTop := tooltips.y + tooltipCursorOffsetY
+
+	if (tooltipLeft + width) > rootRight {
+		tooltipLeft = tooltips.x - width
+	}
+	if (tooltipTop + height) > rootBottom {
+		tooltipTop = tooltips.y - height
+	}
+	tooltipLeft = clampTooltip(tooltipLeft, rootLeft, rootRight-width)
+	tooltipTop = clampTooltip(tooltipTop, rootTop, rootBottom-height)
+	left.RequestValue(tooltipLeft)
+	top.RequestValue(tooltipTop)
+	right.RequestValue(tooltipLeft + width)
+	bottom.RequestValue(tooltipTop + height)
+}
+
+// isCursorOnTooltip returns true if the shown tooltip covers the cursor. This only happens if the root area
+// is too small to place the tooltip next to the cursor. The tooltip then stays, instead of hiding itself.
+func (tooltips *Tooltips) isCursorOnTooltip() bool {
+	tooltipArea := tooltips.tooltipArea
+
+	return (tooltipArea != nil) && tooltipArea.Contains(tooltips.x, tooltips.y)
+}
+
+// clampTooltip limits given start of the tooltip to the range from min to max.
+// The minimum wins for tooltips larger than the root area.
+func clampTooltip(value, min, max float32) float32 {
+	if value > max {
+		value = max
+	}
+	if value < min {
+		value = min
+	}
+	return value
+}
+
+func (tooltips *Tooltips) hide() {
+	if tooltips.tooltipArea != nil {
+		tooltips.label.Dispose()
+		tooltips.label = nil
+		tooltips.tooltipArea.Remove()
+		tooltips.tooltipArea = nil
+	}
+}
+
+func (tooltips *Tooltips) onRender(area *area.Area) {
+	tooltips.rectRenderer.Fill(area.Left().Value(), area.Top().Value(), area.Right().Value(), area.Bottom().Value(),
+		graphics.RGBA(0.0, 0.0, 0.0, 0.8))
+}
@@ -0,0 +1,384 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+	"github.com/dertseha/jellui/input"
+)
+
+// treeIndentation is the horizontal offset of each level of the tree.
+const treeIndentation = 14.0
+
+// treeToggleSize is the size of the square that expands and collapses a node.
+const treeToggleSize = 9.0
+
+// TreeNode is the interface type for nodes within a tree model.
+// Nodes are used as map keys and must be comparable.
+type TreeNode interface{}
+
+// TreeModel provides the hierarchy shown in a tree view.
+// Children are only requested for expanded nodes, which allows them to be loaded on first expansion.
+type TreeModel interface {
+	// IsLeaf returns true if given node has no children and can not be expanded.
+	IsLeaf(node TreeNode) bool
+	// ChildCount returns the number of children of given node. A nil node refers to the invisible root.
+	ChildCount(node TreeNode) int
+	// ChildAt returns the child of given node at given index. A nil node refers to the invisible root.
+	ChildAt(node TreeNode, index int) TreeNode
+	// Label returns the text to display for given node.
+	Label(node TreeNode) string
+}
+
+// TreeSelectionChangeHandler is a callback for notifying the currently selected node.
+type TreeSelectionChangeHandler func(node TreeNode)
+
+// treeRow is one visible node of a tree view.
+type treeRow struct {
+	node      TreeNode
+	depth     int
+	parentRow int
+}
+
+// TreeView is a control for browsing hierarchical data.
+// Nodes can be expanded and collapsed; only the visible rows are rendered.
+type TreeView struct {
+	area         *area.Area
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+	scroller     *rowScroller
+
+	model     TreeModel
+	rowHeight float32
+
+	treeSelectionChangeHandler TreeSelectionChangeHandler
+
+	rowLabels     []*Label
+	rowLabelLefts []area.Anchor
+
+	rows         []treeRow
+	expanded     map[TreeNode]bool
+	selectedNode TreeNode
+}
+
+// Dispose releases all resources and removes the area from the tree.
+func (tree *TreeView) Dispose() {
+	tree.scroller.dispose()
+	tree.area.Remove()
+}
+
+// Refresh queries the model again. It is to be called after the hierarchy has changed.
+// The selection is cleared if the selected node is no longer visible. Does not fire change handler.
+func (tree *TreeView) Refresh() {
+	tree.updateRows()
+	if tree.rowOf(tree.selectedNode) < 0 {
+		tree.selectedNode = nil
+	}
+}
+
+// SelectedNode returns the currently selected node. Returns nil if no node is selected.
+func (tree *TreeView) SelectedNode() TreeNode {
+	return tree.selectedNode
+}
+
+// SetSelectedNode changes which node is selected. The node has to be visible, otherwise the selection is cleared.
+// Does not fire change handler.
+func (tree *TreeView) SetSelectedNode(node TreeNode) {
+	if tree.rowOf(node) >= 0 {
+		tree.selectedNode = node
+	} else {
+		tree.selectedNode = nil
+	}
+}
+
+// IsExpanded returns true if the children of given node are shown.
+func (tree *TreeView) IsExpanded(node TreeNode) bool {
+	return tree.expanded[node]
+}
+
+// Expand shows the children of given node. Leaf nodes are not expanded.
+func (tree *TreeView) Expand(node TreeNode) {
+	if !tree.expanded[node] && !tree.model.IsLeaf(node) {
+		tree.expanded[node] = true
+		tree.updateRows()
+	}
+}
+
+// Collapse hides the children of given node. If this hides the selected node, the collapsed node becomes selected.
+// Does not fire change handler.
+func (tree *TreeView) Collapse(node TreeNode) {
+	if tree.expanded[node] {
+		delete(tree.expanded, node)
+		tree.updateRows()
+		if (tree.selectedNode != nil) && (tree.rowOf(tree.selectedNode) < 0) {
+			tree.selectedNode = node
+		}
+	}
+}
+
+func (tree *TreeView) onRender(area *area.Area) {
+	areaLeft := area.Left().Value()
+	areaTop := area.Top().Value()
+	areaRight := area.Right().Value()
+	areaBottom := area.Bottom().Value()
+	rowRight := areaRight - scrollBarThickness
+	lineColor := graphics.RGBA(0.5, 0.58, 0.33, 1.0)
+
+	tree.rectRenderer.Fill(areaLeft, areaTop, areaRight, areaBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.5))
+	for visibleRow := range tree.rowLabels {
+		rowIndex := tree.scroller.startIndex + visibleRow
+		if rowIndex >= len(tree.rows) {
+			break
+		}
+		row := tree.rows[rowIndex]
+		rowTop := areaTop + float32(visibleRow)*tree.rowHeight
+		rowBottom := rowTop + tree.rowHeight
+		if rowBottom > areaBottom {
+			rowBottom = areaBottom
+		}
+		if (tree.selectedNode != nil) && (row.node == tree.selectedNode) {
+			tree.rectRenderer.Fill(areaLeft, rowTop, rowRight, rowBottom, graphics.RGBA(0.31, 0.56, 0.34, 0.95))
+			if area.HasFocus() {
+				renderOutline(tree.rectRenderer, areaLeft, rowTop, rowRight, rowBottom, lineColor)
+			}
+		}
+		if !tree.model.IsLeaf(row.node) {
+			left, top, right, bottom := tree.toggleBounds(row.depth, rowTop)
+			centerX := float32(int((left + right) / 2))
+			centerY := float32(int((top + bottom) / 2))
+			renderOutline(tree.rectRenderer, left, top, right, bottom, lineColor)
+			tree.rectRenderer.Fill(left+2, centerY, right-2, centerY+1, lineColor)
+			if !tree.expanded[row.node] {
+				tree.rectRenderer.Fill(centerX, top+2, centerX+1, bottom-2, lineColor)
+			}
+		}
+	}
+}
+
+func (tree *TreeView) onBoundsChange(area *area.Area) {
+	tree.scroller.onBoundsChange()
+}
+
+func (tree *TreeView) onMouseDown(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+
+	if !tree.area.Contains(mouseEvent.Position()) {
+		if area.HasFocus() {
+			area.ReleaseFocus()
+		}
+	} else if mouseEvent.AffectedButtons() == input.MousePrimary {
+		area.RequestFocus()
+		rowIndex := tree.rowIndexAt(mouseEvent)
+		if rowIndex >= 0 {
+			row := tree.rows[rowIndex]
+			mouseX, _ := mouseEvent.Position()
+			toggleLeft, _, toggleRight, _ := tree.toggleBounds(row.depth, 0)
+			if (mouseX >= toggleLeft) && (mouseX < toggleRight) && !tree.model.IsLeaf(row.node) {
+				tree.toggleByUser(row.node)
+			} else {
+				tree.selectByUser(row.node)
+			}
+		}
+		consumed = true
+	}
+
+	return
+}
+
+func (tree *TreeView) onMouseDoubleClicked(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseButtonEvent)
+	rowIndex := tree.rowIndexAt(mouseEvent)
+
+	if (rowIndex >= 0) && (mouseEvent.AffectedButtons() == input.MousePrimary) {
+		mouseX, _ := mouseEvent.Position()
+		row := tree.rows[rowIndex]
+		_, _, toggleRight, _ := tree.toggleBounds(row.depth, 0)
+		if mouseX >= toggleRight {
+			tree.toggleByUser(row.node)
+		}
+	}
+	consumed = true
+
+	return
+}
+
+func (tree *TreeView) onMouseScroll(area *area.Area, event events.Event) (consumed bool) {
+	mouseEvent := event.(*events.MouseScrollEvent)
+
+	if tree.area.Contains(mouseEvent.Position()) {
+		_, dy := mouseEvent.Deltas()
+		tree.scroller.scrollByWheel(dy)
+		consumed = true
+	}
+
+	return
+}
+
+func (tree *TreeView) onKey(area *area.Area, event events.Event) (consumed bool) {
+	keyEvent := event.(*events.KeyEvent)
+	rowIndex := tree.rowOf(tree.selectedNode)
+
+	consumed = true
+	switch keyEvent.Key() {
+	case input.KeyUp:
+		tree.moveSelection(rowIndex - 1)
+	case input.KeyDown:
+		tree.moveSelection(rowIndex + 1)
+	case input.KeyPageUp:
+		tree.moveSelection(rowIndex - tree.scroller.fullRowCount())
+	case input.KeyPageDown:
+		tree.moveSelection(rowIndex + tree.scroller.fullRowCount())
+	case input.KeyHome:
+		tree.moveSelection(0)
+	case input.KeyEnd:
+		tree.moveSelection(len(tree.rows) - 1)
+	case input.KeyRight:
+		if rowIndex >= 0 {
+			node := tree.rows[rowIndex].node
+			if tree.expanded[node] {
+				tree.moveSelection(rowIndex + 1)
+			} else if !tree.model.IsLeaf(node) {
+				tree.toggleByUser(node)
+			}
+		}
+	case input.KeyLeft:
+		if rowIndex >= 0 {
+			row := tree.rows[rowIndex]
+			if tree.expanded[row.node] {
+				tree.toggleByUser(row.node)
+			} else if row.parentRow >= 0 {
+				tree.moveSelection(row.parentRow)
+			}
+		}
+	case input.KeyEnter:
+		if (rowIndex >= 0) && !tree.model.IsLeaf(tree.rows[rowIndex].node) {
+			tree.toggleByUser(tree.rows[rowIndex].node)
+		}
+	default:
+		consumed = false
+	}
+
+	return
+}
+
+// rowIndexAt returns the index of the row at the position of given event, or -1 if there is none.
+func (tree *TreeView) rowIndexAt(event events.PositionalEvent) int {
+	_, y := event.Position()
+	rowIndex := tree.scroller.indexAt(y)
+
+	if !tree.area.Contains(event.Position()) || (rowIndex >= len(tree.rows)) {
+		rowIndex = -1
+	}
+	return rowIndex
+}
+
+// rowOf returns the index of the row showing given node, or -1 if the node is not visible.
+func (tree *TreeView) rowOf(node TreeNode) int {
+	if node != nil {
+		for rowIndex, row := range tree.rows {
+			if row.node == node {
+				return rowIndex
+			}
+		}
+	}
+	return -1
+}
+
+// toggleBounds returns the square of the expansion toggle for given depth, in a row starting at rowTop.
+func (tree *TreeView) toggleBounds(depth int, rowTop float32) (left, top, right, bottom float32) {
+	left = tree.area.Left().Value() + float32(depth)*treeIndentation + (treeIndentation-treeToggleSize)/2
+	top = rowTop + float32(int((tree.rowHeight-treeToggleSize)/2))
+	right = left + treeToggleSize
+	bottom = top + treeToggleSize
+	return
+}
+
+func (tree *TreeView) moveSelection(rowIndex int) {
+	if rowIndex >= len(tree.rows) {
+		rowIndex = len(tree.rows) - 1
+	}
+	if rowIndex < 0 {
+		rowIndex = 0
+	}
+	if len(tree.rows) > 0 {
+		tree.selectByUser(tree.rows[rowIndex].node)
+	}
+}
+
+func (tree *TreeView) selectByUser(node TreeNode) {
+	tree.scroller.scrollToIndex(tree.rowOf(node))
+	if node != tree.selectedNode {
+		tree.selectedNode = node
+		tree.treeSelectionChangeHandler(node)
+	}
+}
+
+// toggleByUser expands or collapses given node. Collapsing a node that contains the selection selects the node.
+func (tree *TreeView) toggleByUser(node TreeNode) {
+	if tree.expanded[node] {
+		delete(tree.expanded, node)
+		tree.updateRows()
+		if (tree.selectedNode != nil) && (tree.rowOf(tree.selectedNode) < 0) {
+			tree.selectByUser(node)
+		}
+	} else {
+		tree.Expand(node)
+	}
+}
+
+func (tree *TreeView) updateRows() {
+	tree.rows = nil
+	tree.appendChildRows(nil, 0, -1)
+	tree.scroller.scrollTo(tree.scroller.startIndex)
+	tree.updateRowLabels()
+}
+
+func (tree *TreeView) appendChildRows(parent TreeNode, depth int, parentRow int) {
+	childCount := tree.model.ChildCount(parent)
+
+	for childIndex := 0; childIndex < childCount; childIndex++ {
+		child := tree.model.ChildAt(parent, childIndex)
+		tree.rows = append(tree.rows, treeRow{node: child, depth: depth, parentRow: parentRow})
+		if tree.expanded[child] {
+			tree.appendChildRows(child, depth+1, len(tree.rows)-1)
+		}
+	}
+}
+
+func (tree *TreeView) rowCount() int {
+	return len(tree.rows)
+}
+
+func (tree *TreeView) appendRowLabels(top area.Anchor) {
+	left := area.NewOffsetAnchor(tree.area.Left(), treeIndentation)
+
+	tree.labelBuilder.SetParent(tree.area)
+	tree.labelBuilder.AlignedHorizontallyBy(LeftAligner)
+	tree.labelBuilder.SetLeft(left)
+	tree.labelBuilder.SetRight(area.NewOffsetAnchor(tree.area.Right(), -scrollBarThickness-4))
+	tree.labelBuilder.SetTop(top)
+	tree.labelBuilder.SetBottom(area.NewOffsetAnchor(top, tree.rowHeight))
+	tree.rowLabels = append(tree.rowLabels, tree.labelBuilder.Build())
+	tree.rowLabelLefts = append(tree.rowLabelLefts, left)
+}
+
+func (tree *TreeView) disposeRowLabels() {
+	for _, label := range tree.rowLabels {
+		label.Dispose()
+	}
+	tree.rowLabels = nil
+	tree.rowLabelLefts = nil
+}
+
+func (tree *TreeView) updateRowLabels() {
+	for visibleRow, label := range tree.rowLabels {
+		rowIndex := tree.scroller.startIndex + visibleRow
+		if rowIndex < len(tree.rows) {
+			row := tree.rows[rowIndex]
+			tree.rowLabelLefts[visibleRow].RequestValue(tree.area.Left().Value() + float32(row.depth+1)*treeIndentation)
+			label.SetText(tree.model.Label(row.node))
+		} else {
+			label.SetText("")
+		}
+	}
+}
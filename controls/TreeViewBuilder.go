@@ -0,0 +1,137 @@
+package controls
+
+import (
+	"github.com/dertseha/jellui/area"
+	"github.com/dertseha/jellui/area/events"
+	"github.com/dertseha/jellui/graphics"
+)
+
+type emptyTreeModel struct{}
+
+func (model emptyTreeModel) IsLeaf(node TreeNode) bool {
+	return true
+}
+
+func (model emptyTreeModel) ChildCount(node TreeNode) int {
+	return 0
+}
+
+func (model emptyTreeModel) ChildAt(node TreeNode, index int) TreeNode {
+	return nil
+}
+
+func (model emptyTreeModel) Label(node TreeNode) string {
+	return ""
+}
+
+// TreeViewBuilder is a builder for TreeView instances.
+type TreeViewBuilder struct {
+	areaBuilder  *area.AreaBuilder
+	rectRenderer *graphics.RectangleRenderer
+	labelBuilder *LabelBuilder
+
+	model     TreeModel
+	rowHeight float32
+
+	treeSelectionChangeHandler TreeSelectionChangeHandler
+}
+
+// NewTreeViewBuilder returns a new TreeViewBuilder instance.
+func NewTreeViewBuilder(labelBuilder *LabelBuilder, rectRenderer *graphics.RectangleRenderer) *TreeViewBuilder {
+	builder := &TreeViewBuilder{
+		areaBuilder:                area.NewAreaBuilder(),
+		rectRenderer:               rectRenderer,
+		labelBuilder:               labelBuilder,
+		model:                      emptyTreeModel{},
+		rowHeight:                  20,
+		treeSelectionChangeHandler: func(TreeNode) {}}
+
+	return builder
+}
+
+// Build creates a new TreeView instance from the current parameters.
+// Initially, all nodes are collapsed.
+func (builder *TreeViewBuilder) Build() *TreeView {
+	tree := &TreeView{
+		rectRenderer:               builder.rectRenderer,
+		labelBuilder:               builder.labelBuilder,
+		model:                      builder.model,
+		rowHeight:                  builder.rowHeight,
+		treeSelectionChangeHandler: builder.treeSelectionChangeHandler,
+		expanded:                   make(map[TreeNode]bool)}
+
+	builder.areaBuilder.SetClipChildren(true)
+	builder.areaBuilder.OnRender(tree.onRender)
+	builder.areaBuilder.OnBoundsChange(tree.onBoundsChange)
+	builder.areaBuilder.OnEvent(events.MouseButtonDownEventType, tree.onMouseDown)
+	builder.areaBuilder.OnEvent(events.MouseButtonDoubleClickedEventType, tree.onMouseDoubleClicked)
+	builder.areaBuilder.OnEvent(events.MouseScrollEventType, tree.onMouseScroll)
+	builder.areaBuilder.OnEvent(events.MouseButtonClickedEventType, area.SilentConsumer)
+	builder.areaBuilder.OnEvent(events.KeyEventType, tree.onKey)
+	builder.areaBuilder.SetFocusable(true)
+	tree.area = builder.areaBuilder.Build()
+	tree.scroller = newRowScroller(tree.area, builder.rectRenderer, tree, tree.rowHeight, 0)
+	tree.updateRows()
+
+	return tree
+}
+
+// SetParent sets the parent area.
+func (builder *TreeViewBuilder) SetParent(parent *area.Area) *TreeViewBuilder {
+	builder.areaBuilder.SetParent(parent)
+	return builder
+}
+
+// SetLeft sets the left anchor. Default: ZeroAnchor
+func (builder *TreeViewBuilder) SetLeft(value area.Anchor) *TreeViewBuilder {
+	builder.areaBuilder.SetLeft(value)
+	return builder
+}
+
+// SetTop sets the top anchor. Default: ZeroAnchor
+func (builder *TreeViewBuilder) SetTop(value area.Anchor) *TreeViewBuilder {
+	builder.areaBuilder.SetTop(value)
+	return builder
+}
+
+// SetRight sets the right anchor. Default: ZeroAnchor
+func (builder *TreeViewBuilder) SetRight(value area.Anchor) *TreeViewBuilder {
+	builder.areaBuilder.SetRight(value)
+	return builder
+}
+
+// SetBottom sets the bottom anchor. Default: ZeroAnchor
+func (builder *TreeViewBuilder) SetBottom(value area.Anchor) *TreeViewBuilder {
+	builder.areaBuilder.SetBottom(value)
+	return builder
+}
+
+// SetTabIndex sets the position in the keyboard navigation order. Default: 0
+func (builder *TreeViewBuilder) SetTabIndex(value int) *TreeViewBuilder {
+	builder.areaBuilder.SetTabIndex(value)
+	return builder
+}
+
+// SetTooltip sets the explanatory text shown while the cursor rests on the tree. Default: ""
+func (builder *TreeViewBuilder) SetTooltip(text string) *TreeViewBuilder {
+	builder.areaBuilder.SetTooltip(text)
+	return builder
+}
+
+// WithModel sets the source of the hierarchy. Default: no nodes
+func (builder *TreeViewBuilder) WithModel(model TreeModel) *TreeViewBuilder {
+	builder.model = model
+	return builder
+}
+
+// WithRowHeight sets the height of each row. Default: 20
+func (builder *TreeViewBuilder) WithRowHeight(value float32) *TreeViewBuilder {
+	builder.rowHeight = value
+	return builder
+}
+
+// WithTreeSelectionChangeHandler sets the handler for a change of the selected node.
+func (builder *TreeViewBuilder) WithTreeSelectionChangeHandler(handler TreeSelectionChangeHandler) *TreeViewBuilder {
+	builder.treeSelectionChangeHandler = handler
+	return builder
+}
@@ -53,6 +53,11 @@ type OpenGlWindow interface {
 	// SetCursorVisible controls whether the mouse cursor is currently visible.
 	SetCursorVisible(visible bool)
 
+	// ClipboardString returns the current textual content of the clipboard.
+	ClipboardString() (string, error)
+	// SetClipboardString sets the textual content of the clipboard.
+	SetClipboardString(value string)
+
 	// OnMouseMove registers a callback function for mouse move events.
 	OnMouseMove(callback MouseMoveCallback)
 	// OnMouseButtonDown registers a callback function for mouse button down events.
@@ -213,6 +213,11 @@ func (native *OpenGl) ReadPixels(x int32, y int32, width int32, height int32, fo
 	gl.ReadPixels(x, y, width, height, format, pixelType, gl.Ptr(pixels))
 }
 
+// Scissor implements the opengl.OpenGl interface.
+func (native *OpenGl) Scissor(x int32, y int32, width int32, height int32) {
+	gl.Scissor(x, y, width, height)
+}
+
 // ShaderSource implements the opengl.OpenGl interface.
 func (native *OpenGl) ShaderSource(shader uint32, source string) {
 	csources, free := gl.Strs(source + "\x00")
@@ -116,6 +116,16 @@ func (window *OpenGlWindow) SetCursorVisible(visible bool) {
 	}
 }
 
+// ClipboardString implements the OpenGlWindow interface.
+func (window *OpenGlWindow) ClipboardString() (string, error) {
+	return window.glfwWindow.GetClipboardString()
+}
+
+// SetClipboardString implements the OpenGlWindow interface.
+func (window *OpenGlWindow) SetClipboardString(value string) {
+	window.glfwWindow.SetClipboardString(value)
+}
+
 // SetFullScreen implements the OpenGlWindow interface.
 func (window *OpenGlWindow) SetFullScreen(on bool) {
 	if on {
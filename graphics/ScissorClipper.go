@@ -0,0 +1,41 @@
+package graphics
+
+import (
+	"math"
+
+	"github.com/dertseha/jellui/opengl"
+)
+
+// ScissorClipper restricts rendering by using the scissor test of OpenGL.
+// It implements the area.Clipper interface.
+type ScissorClipper struct {
+	gl             opengl.OpenGl
+	viewportHeight float32
+}
+
+// NewScissorClipper returns a new clipper for given OpenGL interface.
+func NewScissorClipper(gl opengl.OpenGl) *ScissorClipper {
+	return &ScissorClipper{gl: gl}
+}
+
+// SetViewportHeight sets the height of the viewport. The scissor rectangle is
+// based on the bottom of the viewport, while the clip rectangle is based on the top.
+func (clipper *ScissorClipper) SetViewportHeight(height int) {
+	clipper.viewportHeight = float32(height)
+}
+
+// ClipTo restricts any further rendering to given rectangle.
+func (clipper *ScissorClipper) ClipTo(left, top, right, bottom float32) {
+	x := int32(math.Floor(float64(left)))
+	y := int32(math.Floor(float64(clipper.viewportHeight - bottom)))
+	width := int32(math.Ceil(float64(right))) - x
+	height := int32(math.Ceil(float64(clipper.viewportHeight-top))) - y
+
+	clipper.gl.Enable(opengl.SCISSOR_TEST)
+	clipper.gl.Scissor(x, y, width, height)
+}
+
+// Unclip removes any restriction.
+func (clipper *ScissorClipper) Unclip() {
+	clipper.gl.Disable(opengl.SCISSOR_TEST)
+}
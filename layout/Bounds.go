@@ -0,0 +1,24 @@
+package layout
+
+import (
+	"github.com/dertseha/jellui/area"
+)
+
+// Bounds describes the rectangle a layout places its items in.
+// An *area.Area provides bounds.
+type Bounds interface {
+	Left() area.Anchor
+	Top() area.Anchor
+	Right() area.Anchor
+	Bottom() area.Anchor
+}
+
+// Padding specifies the distance between the bounds of a container and its items.
+type Padding struct {
+	Left, Top, Right, Bottom float32
+}
+
+// UniformPadding returns a padding with the same distance on all sides.
+func UniformPadding(value float32) Padding {
+	return Padding{Left: value, Top: value, Right: value, Bottom: value}
+}
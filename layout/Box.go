@@ -0,0 +1,82 @@
+package layout
+
+// Box places its items next to each other, either vertically or horizontally.
+// Along the main axis, the items have their preferred size and share any remaining space according
+// to their weight. Along the cross axis, the items are aligned within the box.
+type Box struct {
+	container
+	vertical bool
+}
+
+// NewVerticalBox returns a box that stacks its items from top to bottom.
+func NewVerticalBox(bounds Bounds) *Box {
+	box := &Box{container: newContainer(bounds), vertical: true}
+	box.arrange = box.arrangeItems
+	return box
+}
+
+// NewHorizontalBox returns a box that lines up its items from left to right.
+func NewHorizontalBox(bounds Bounds) *Box {
+	box := &Box{container: newContainer(bounds), vertical: false}
+	box.arrange = box.arrangeItems
+	return box
+}
+
+// SetSpacing sets the distance between two items. Default: 0
+func (box *Box) SetSpacing(value float32) {
+	box.spacing = value
+	box.invalidate()
+}
+
+// SetPadding sets the distance between the bounds and the items. Default: no padding
+func (box *Box) SetPadding(value Padding) {
+	box.padding = value
+	box.invalidate()
+}
+
+// Add appends an item to the box and returns the cell providing its anchors.
+func (box *Box) Add(item Item) *Cell {
+	return box.add(item)
+}
+
+func (box *Box) arrangeItems(inner rectangle, items []Item) []rectangle {
+	mainStart, crossStart, mainEnd, crossEnd := leftEdge, topEdge, rightEdge, bottomEdge
+	mainSize := func(item Item) float32 { return item.PreferredWidth }
+	crossSize := func(item Item) float32 { return item.PreferredHeight }
+	crossAlignment := func(item Item) Alignment { return item.VerticalAlignment }
+	if box.vertical {
+		mainStart, crossStart, mainEnd, crossEnd = topEdge, leftEdge, bottomEdge, rightEdge
+		mainSize, crossSize = crossSize, mainSize
+		crossAlignment = func(item Item) Alignment { return item.HorizontalAlignment }
+	}
+
+	remaining := inner[mainEnd] - inner[mainStart]
+	totalWeight := float32(0.0)
+	for index, item := range items {
+		remaining -= mainSize(item)
+		if index > 0 {
+			remaining -= box.spacing
+		}
+		totalWeight += item.Weight
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	result := make([]rectangle, len(items))
+	position := inner[mainStart]
+	for index, item := range items {
+		var rect rectangle
+		size := mainSize(item)
+
+		if totalWeight > 0 {
+			size += remaining * item.Weight / totalWeight
+		}
+		rect[mainStart], rect[mainEnd] = position, position+size
+		rect[crossStart], rect[crossEnd] = crossAlignment(item).place(inner[crossStart], inner[crossEnd], crossSize(item))
+		result[index] = rect
+		position += size + box.spacing
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package layout
+
+import (
+	"github.com/dertseha/jellui/area"
+
+	check "gopkg.in/check.v1"
+)
+
+type BoxSuite struct {
+	bounds *area.Area
+}
+
+var _ = check.Suite(&BoxSuite{})
+
+func (suite *BoxSuite) SetUpTest(c *check.C) {
+	builder := area.NewAreaBuilder()
+	builder.SetLeft(area.NewAbsoluteAnchor(10.0))
+	builder.SetTop(area.NewAbsoluteAnchor(20.0))
+	builder.SetRight(area.NewAbsoluteAnchor(110.0))
+	builder.SetBottom(area.NewAbsoluteAnchor(220.0))
+	suite.bounds = builder.Build()
+}
+
+func (suite *BoxSuite) rectOf(cell *Cell) []float32 {
+	return []float32{cell.Left().Value(), cell.Top().Value(), cell.Right().Value(), cell.Bottom().Value()}
+}
+
+func (suite *BoxSuite) TestVerticalBoxStacksItemsWithPreferredHeight(c *check.C) {
+	box := NewVerticalBox(suite.bounds)
+	first := box.Add(Item{PreferredHeight: 30.0})
+	second := box.Add(Item{PreferredHeight: 40.0})
+
+	c.Check(suite.rectOf(first), check.DeepEquals, []float32{10.0, 20.0, 110.0, 50.0})
+	c.Check(suite.rectOf(second), check.DeepEquals, []float32{10.0, 50.0, 110.0, 90.0})
+}
+
+func (suite *BoxSuite) TestHorizontalBoxLinesUpItemsWithPreferredWidth(c *check.C) {
+	box := NewHorizontalBox(suite.bounds)
+	first := box.Add(Item{PreferredWidth: 30.0})
+	second := box.Add(Item{PreferredWidth: 40.0})
+
+	c.Check(suite.rectOf(first), check.DeepEquals, []float32{10.0, 20.0, 40.0, 220.0})
+	c.Check(suite.rectOf(second), check.DeepEquals, []float32{40.0, 20.0, 80.0, 220.0})
+}
+
+func (suite *BoxSuite) TestSpacingAndPaddingAreConsidered(c *check.C) {
+	box := NewVerticalBox(suite.bounds)
+	box.SetSpacing(5.0)
+	box.SetPadding(Padding{Left: 1.0, Top: 2.0, Right: 3.0, Bottom: 4.0})
+	first := box.Add(Item{PreferredHeight: 30.0})
+	second := box.Add(Item{PreferredHeight: 40.0})
+
+	c.Check(suite.rectOf(first), check.DeepEquals, []float32{11.0, 22.0, 107.0, 52.0})
+	c.Check(suite.rectOf(second), check.DeepEquals, []float32{11.0, 57.0, 107.0, 97.0})
+}
+
+func (suite *BoxSuite) TestWeightsShareRemainingSpace(c *check.C) {
+	box := NewHorizontalBox(suite.bounds)
+	fixed := box.Add(Item{PreferredWidth: 40.0})
+	single := box.Add(Item{Weight: 1.0})
+	double := box.Add(Item{Weight: 2.0})
+
+	c.Check(suite.rectOf(fixed), check.DeepEquals, []float32{10.0, 20.0, 50.0, 220.0})
+	c.Check(suite.rectOf(single), check.DeepEquals, []float32{50.0, 20.0, 70.0, 220.0})
+	c.Check(suite.rectOf(double), check.DeepEquals, []float32{70.0, 20.0, 110.0, 220.0})
+}
+
+func (suite *BoxSuite) TestCrossAxisAlignment(c *check.C) {
+	box := NewVerticalBox(suite.bounds)
+	start := box.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0, HorizontalAlignment: AlignStart})
+	center := box.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0, HorizontalAlignment: AlignCenter})
+	end := box.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0, HorizontalAlignment: AlignEnd})
+
+	c.Check(suite.rectOf(start), check.DeepEquals, []float32{10.0, 20.0, 30.0, 30.0})
+	c.Check(suite.rectOf(center), check.DeepEquals, []float32{50.0, 30.0, 70.0, 40.0})
+	c.Check(suite.rectOf(end), check.DeepEquals, []float32{90.0, 40.0, 110.0, 50.0})
+}
+
+func (suite *BoxSuite) TestItemsReflowWhenBoundsChange(c *check.C) {
+	box := NewHorizontalBox(suite.bounds)
+	box.Add(Item{PreferredWidth: 40.0})
+	stretched := box.Add(Item{Weight: 1.0})
+
+	c.Check(stretched.Right().Value(), check.Equals, float32(110.0))
+	suite.bounds.Right().RequestValue(210.0)
+	c.Check(stretched.Right().Value(), check.Equals, float32(210.0))
+}
+
+func (suite *BoxSuite) TestSetItemRearrangesItems(c *check.C) {
+	box := NewVerticalBox(suite.bounds)
+	first := box.Add(Item{PreferredHeight: 30.0})
+	second := box.Add(Item{PreferredHeight: 40.0})
+
+	first.SetItem(Item{PreferredHeight: 50.0})
+
+	c.Check(second.Top().Value(), check.Equals, float32(70.0))
+}
+
+func (suite *BoxSuite) TestCellAnchorsIgnoreRequests(c *check.C) {
+	box := NewVerticalBox(suite.bounds)
+	cell := box.Add(Item{PreferredHeight: 30.0})
+
+	cell.Top().RequestValue(100.0)
+
+	c.Check(cell.Top().Value(), check.Equals, float32(20.0))
+}
+
+func (suite *BoxSuite) TestAreasAnchoredToCellsDetectRearrangement(c *check.C) {
+	box := NewVerticalBox(suite.bounds)
+	cell := box.Add(Item{PreferredHeight: 30.0})
+	calls := 0
+	builder := area.NewAreaBuilder()
+	builder.SetLeft(cell.Left())
+	builder.SetTop(cell.Top())
+	builder.SetRight(cell.Right())
+	builder.SetBottom(cell.Bottom())
+	builder.OnBoundsChange(func(*area.Area) { calls++ })
+	cellArea := builder.Build()
+
+	cellArea.Render()
+	cell.SetItem(Item{PreferredHeight: 40.0})
+	cellArea.Render()
+
+	c.Check(calls, check.Equals, 2)
+}
@@ -0,0 +1,71 @@
+package layout
+
+import (
+	"github.com/dertseha/jellui/area"
+)
+
+type edge int
+
+const (
+	leftEdge   = edge(0)
+	topEdge    = edge(1)
+	rightEdge  = edge(2)
+	bottomEdge = edge(3)
+)
+
+type rectangle [4]float32
+
+// Cell represents the placement of one item within a layout.
+// Its anchors follow the layout, requests to set their value are ignored.
+type Cell struct {
+	container *container
+	index     int
+}
+
+// Left returns the left anchor of the cell.
+func (cell *Cell) Left() area.Anchor {
+	return &cellAnchor{cell: cell, edge: leftEdge}
+}
+
+// Top returns the top anchor of the cell.
+func (cell *Cell) Top() area.Anchor {
+	return &cellAnchor{cell: cell, edge: topEdge}
+}
+
+// Right returns the right anchor of the cell.
+func (cell *Cell) Right() area.Anchor {
+	return &cellAnchor{cell: cell, edge: rightEdge}
+}
+
+// Bottom returns the bottom anchor of the cell.
+func (cell *Cell) Bottom() area.Anchor {
+	return &cellAnchor{cell: cell, edge: bottomEdge}
+}
+
+// Item returns the current requirements of the cell.
+func (cell *Cell) Item() Item {
+	return cell.container.items[cell.index]
+}
+
+// SetItem changes the requirements of the cell, causing the layout to be arranged again.
+func (cell *Cell) SetItem(item Item) {
+	cell.container.items[cell.index] = item
+	cell.container.invalidate()
+}
+
+type cellAnchor struct {
+	cell *Cell
+	edge edge
+}
+
+func (anchor *cellAnchor) Value() float32 {
+	return anchor.cell.container.rectangleOf(anchor.cell.index)[anchor.edge]
+}
+
+func (anchor *cellAnchor) RequestValue(newValue float32) {
+}
+
+func (anchor *cellAnchor) Dependencies() []area.Anchor {
+	bounds := anchor.cell.container.bounds
+	return []area.Anchor{bounds.Left(), bounds.Top(), bounds.Right(), bounds.Bottom(), anchor.cell.container.revision}
+}
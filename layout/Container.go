@@ -0,0 +1,62 @@
+package layout
+
+import (
+	"github.com/dertseha/jellui/area"
+)
+
+// arrangeFunction places the items of a container within given inner rectangle.
+type arrangeFunction func(inner rectangle, items []Item) []rectangle
+
+// container is the common base of all layouts. It keeps the items and caches their placement
+// for the current bounds.
+type container struct {
+	bounds  Bounds
+	padding Padding
+	spacing float32
+	arrange arrangeFunction
+
+	items []Item
+
+	// revision is changed with every invalidation, which lets areas anchored to cells detect the new arrangement.
+	revision area.Anchor
+
+	valid      bool
+	lastBounds rectangle
+	rectangles []rectangle
+}
+
+func newContainer(bounds Bounds) container {
+	return container{bounds: bounds, revision: area.NewAbsoluteAnchor(0)}
+}
+
+func (container *container) add(item Item) *Cell {
+	container.items = append(container.items, item)
+	container.invalidate()
+	return &Cell{container: container, index: len(container.items) - 1}
+}
+
+func (container *container) invalidate() {
+	container.valid = false
+	container.revision.RequestValue(container.revision.Value() + 1)
+}
+
+func (container *container) rectangleOf(index int) rectangle {
+	current := rectangle{
+		container.bounds.Left().Value(),
+		container.bounds.Top().Value(),
+		container.bounds.Right().Value(),
+		container.bounds.Bottom().Value()}
+
+	if !container.valid || (current != container.lastBounds) {
+		inner := rectangle{
+			current[leftEdge] + container.padding.Left,
+			current[topEdge] + container.padding.Top,
+			current[rightEdge] - container.padding.Right,
+			current[bottomEdge] - container.padding.Bottom}
+		container.rectangles = container.arrange(inner, container.items)
+		container.lastBounds = current
+		container.valid = true
+	}
+
+	return container.rectangles[index]
+}
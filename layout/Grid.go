@@ -0,0 +1,115 @@
+package layout
+
+// Grid places its items in rows of a fixed number of columns.
+// Each column is as wide as its widest item, each row as high as its highest item.
+// Remaining space is shared among the columns and rows according to their weight.
+type Grid struct {
+	container
+	columns int
+
+	columnWeights map[int]float32
+	rowWeights    map[int]float32
+}
+
+// NewGrid returns a grid with given number of columns. Items are added row by row.
+func NewGrid(bounds Bounds, columns int) *Grid {
+	if columns < 1 {
+		columns = 1
+	}
+	grid := &Grid{
+		container:     newContainer(bounds),
+		columns:       columns,
+		columnWeights: make(map[int]float32),
+		rowWeights:    make(map[int]float32)}
+	grid.arrange = grid.arrangeItems
+	return grid
+}
+
+// SetSpacing sets the distance between two columns, and two rows. Default: 0
+func (grid *Grid) SetSpacing(value float32) {
+	grid.spacing = value
+	grid.invalidate()
+}
+
+// SetPadding sets the distance between the bounds and the items. Default: no padding
+func (grid *Grid) SetPadding(value Padding) {
+	grid.padding = value
+	grid.invalidate()
+}
+
+// SetColumnWeight sets the share of the remaining width for given column. Default: 0
+func (grid *Grid) SetColumnWeight(column int, weight float32) {
+	grid.columnWeights[column] = weight
+	grid.invalidate()
+}
+
+// SetRowWeight sets the share of the remaining height for given row. Default: 0
+func (grid *Grid) SetRowWeight(row int, weight float32) {
+	grid.rowWeights[row] = weight
+	grid.invalidate()
+}
+
+// Add appends an item to the grid and returns the cell providing its anchors.
+func (grid *Grid) Add(item Item) *Cell {
+	return grid.add(item)
+}
+
+func (grid *Grid) arrangeItems(inner rectangle, items []Item) []rectangle {
+	rows := (len(items) + grid.columns - 1) / grid.columns
+	widths := make([]float32, grid.columns)
+	heights := make([]float32, rows)
+
+	for index, item := range items {
+		column, row := index%grid.columns, index/grid.columns
+		if item.PreferredWidth > widths[column] {
+			widths[column] = item.PreferredWidth
+		}
+		if item.PreferredHeight > heights[row] {
+			heights[row] = item.PreferredHeight
+		}
+	}
+	columnStarts := grid.distribute(inner[leftEdge], inner[rightEdge], widths, grid.columnWeights)
+	rowStarts := grid.distribute(inner[topEdge], inner[bottomEdge], heights, grid.rowWeights)
+
+	result := make([]rectangle, len(items))
+	for index, item := range items {
+		var rect rectangle
+		column, row := index%grid.columns, index/grid.columns
+
+		rect[leftEdge], rect[rightEdge] = item.HorizontalAlignment.place(
+			columnStarts[column], columnStarts[column]+widths[column], item.PreferredWidth)
+		rect[topEdge], rect[bottomEdge] = item.VerticalAlignment.place(
+			rowStarts[row], rowStarts[row]+heights[row], item.PreferredHeight)
+		result[index] = rect
+	}
+
+	return result
+}
+
+// distribute extends the given sizes by their share of the remaining space and returns the start positions.
+func (grid *Grid) distribute(start, end float32, sizes []float32, weights map[int]float32) []float32 {
+	remaining := end - start
+	totalWeight := float32(0.0)
+	for index, size := range sizes {
+		remaining -= size
+		if index > 0 {
+			remaining -= grid.spacing
+		}
+		totalWeight += weights[index]
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	starts := make([]float32, len(sizes))
+	position := start
+	for index := range sizes {
+		if totalWeight > 0 {
+			sizes[index] += remaining * weights[index] / totalWeight
+		}
+		starts[index] = position
+		position += sizes[index] + grid.spacing
+	}
+
+	return starts
+}
@@ -0,0 +1,57 @@
+package layout
+
+import (
+	"github.com/dertseha/jellui/area"
+
+	check "gopkg.in/check.v1"
+)
+
+type GridSuite struct {
+	bounds *area.Area
+}
+
+var _ = check.Suite(&GridSuite{})
+
+func (suite *GridSuite) SetUpTest(c *check.C) {
+	builder := area.NewAreaBuilder()
+	builder.SetRight(area.NewAbsoluteAnchor(100.0))
+	builder.SetBottom(area.NewAbsoluteAnchor(100.0))
+	suite.bounds = builder.Build()
+}
+
+func (suite *GridSuite) rectOf(cell *Cell) []float32 {
+	return []float32{cell.Left().Value(), cell.Top().Value(), cell.Right().Value(), cell.Bottom().Value()}
+}
+
+func (suite *GridSuite) TestItemsArePlacedRowByRow(c *check.C) {
+	grid := NewGrid(suite.bounds, 2)
+	cells := []*Cell{
+		grid.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0}),
+		grid.Add(Item{PreferredWidth: 30.0, PreferredHeight: 15.0}),
+		grid.Add(Item{PreferredWidth: 25.0, PreferredHeight: 10.0})}
+
+	c.Check(suite.rectOf(cells[0]), check.DeepEquals, []float32{0.0, 0.0, 25.0, 15.0})
+	c.Check(suite.rectOf(cells[1]), check.DeepEquals, []float32{25.0, 0.0, 55.0, 15.0})
+	c.Check(suite.rectOf(cells[2]), check.DeepEquals, []float32{0.0, 15.0, 25.0, 25.0})
+}
+
+func (suite *GridSuite) TestWeightsShareRemainingSpace(c *check.C) {
+	grid := NewGrid(suite.bounds, 2)
+	grid.SetSpacing(10.0)
+	grid.SetColumnWeight(1, 1.0)
+	grid.SetRowWeight(0, 1.0)
+	fixed := grid.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0})
+	stretched := grid.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0})
+
+	c.Check(suite.rectOf(fixed), check.DeepEquals, []float32{0.0, 0.0, 20.0, 100.0})
+	c.Check(suite.rectOf(stretched), check.DeepEquals, []float32{30.0, 0.0, 100.0, 100.0})
+}
+
+func (suite *GridSuite) TestItemsAreAlignedWithinTheirCell(c *check.C) {
+	grid := NewGrid(suite.bounds, 1)
+	grid.SetColumnWeight(0, 1.0)
+	cell := grid.Add(Item{PreferredWidth: 20.0, PreferredHeight: 10.0,
+		HorizontalAlignment: AlignCenter, VerticalAlignment: AlignEnd})
+
+	c.Check(suite.rectOf(cell), check.DeepEquals, []float32{40.0, 0.0, 60.0, 10.0})
+}
@@ -0,0 +1,44 @@
+package layout
+
+// Alignment specifies how an item is placed within the space available to it.
+type Alignment int
+
+const (
+	// AlignStretch lets the item fill the available space.
+	AlignStretch = Alignment(0)
+	// AlignStart places the item with its preferred size at the left, or top.
+	AlignStart = Alignment(1)
+	// AlignCenter places the item with its preferred size in the center.
+	AlignCenter = Alignment(2)
+	// AlignEnd places the item with its preferred size at the right, or bottom.
+	AlignEnd = Alignment(3)
+)
+
+// Item describes the space requirements of an entry in a layout.
+type Item struct {
+	// PreferredWidth is the width the item wants to have.
+	PreferredWidth float32
+	// PreferredHeight is the height the item wants to have.
+	PreferredHeight float32
+	// Weight determines the share of the remaining space along the main axis of a box.
+	// Items with a weight of zero keep their preferred size.
+	Weight float32
+	// HorizontalAlignment places the item horizontally within its space.
+	HorizontalAlignment Alignment
+	// VerticalAlignment places the item vertically within its space.
+	VerticalAlignment Alignment
+}
+
+func (alignment Alignment) place(start, end, preferred float32) (float32, float32) {
+	switch alignment {
+	case AlignStart:
+		return start, start + preferred
+	case AlignCenter:
+		center := start + (end-start)/2
+		return center - preferred/2, center + preferred/2
+	case AlignEnd:
+		return end - preferred, end
+	default:
+		return start, end
+	}
+}
@@ -281,6 +281,13 @@ func (debugging *debuggingOpenGl) ReadPixels(x int32, y int32, width int32, heig
 	debugging.recordExit("ReadPixels")
 }
 
+// Scissor implements the OpenGl interface.
+func (debugging *debuggingOpenGl) Scissor(x int32, y int32, width int32, height int32) {
+	debugging.recordEntry("Scissor", x, y, width, height)
+	debugging.gl.Scissor(x, y, width, height)
+	debugging.recordExit("Scissor")
+}
+
 // ShaderSource implements the OpenGl interface.
 func (debugging *debuggingOpenGl) ShaderSource(shader uint32, source string) {
 	debugging.recordEntry("ShaderSource", shader, source)
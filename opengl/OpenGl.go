@@ -51,6 +51,7 @@ type OpenGl interface {
 
 	ReadPixels(x int32, y int32, width int32, height int32, format uint32, pixelType uint32, pixels interface{})
 
+	Scissor(x int32, y int32, width int32, height int32)
 	ShaderSource(shader uint32, source string)
 
 	TexImage2D(target uint32, level int32, internalFormat uint32, width int32, height int32,
@@ -44,8 +44,9 @@ const (
 
 // Features
 const (
-	BLEND      uint32 = 0x0BE2
-	DEPTH_TEST        = 0x0B71
+	BLEND        uint32 = 0x0BE2
+	DEPTH_TEST          = 0x0B71
+	SCISSOR_TEST        = 0x0C11
 )
 
 // Alpha constants